@@ -2,6 +2,7 @@ package hivego
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/deathwingtheboss/hivego/types"
 )
@@ -25,3 +26,14 @@ func (h *HiveRpcNode) GetAccount(accountNames []string) ([]types.AccountData, er
 	}
 	return accountData, nil
 }
+
+func (h *HiveRpcNode) getAccountData(account string) (types.AccountData, error) {
+	accounts, err := h.GetAccount([]string{account})
+	if err != nil {
+		return types.AccountData{}, err
+	}
+	if len(accounts) == 0 {
+		return types.AccountData{}, errors.New("account not found: " + account)
+	}
+	return accounts[0], nil
+}
@@ -0,0 +1,137 @@
+package hivego
+
+import "errors"
+
+type ClaimAccountOperation struct {
+	Creator string `json:"creator"`
+	Fee     string `json:"fee"`
+}
+
+func (o ClaimAccountOperation) opName() string {
+	return "claim_account"
+}
+
+func (o ClaimAccountOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Creator}}
+}
+
+// ClaimAccount claims an account creation ticket for creator, to be used later
+// with CreateClaimedAccount. A fee of "0.000 HIVE" pays with resource credits
+// instead of HIVE.
+func (h *HiveRpcNode) ClaimAccount(creator string, fee string, wif *string) (string, error) {
+	op := ClaimAccountOperation{Creator: creator, Fee: fee}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type CreateClaimedAccountOperation struct {
+	Creator        string    `json:"creator"`
+	NewAccountName string    `json:"new_account_name"`
+	Owner          Authority `json:"owner"`
+	Active         Authority `json:"active"`
+	Posting        Authority `json:"posting"`
+	MemoKey        string    `json:"memo_key"`
+	JsonMetadata   string    `json:"json_metadata"`
+}
+
+func (o CreateClaimedAccountOperation) opName() string {
+	return "create_claimed_account"
+}
+
+func (o CreateClaimedAccountOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Creator}}
+}
+
+// CreateClaimedAccount creates newAccountName with the given keys, using an
+// account creation ticket previously claimed by creator.
+func (h *HiveRpcNode) CreateClaimedAccount(creator string, newAccountName string, keys *AccountKeys, jsonMetadata string, wif *string) (string, error) {
+	owner, active, posting, memoKey, err := keys.authorities()
+	if err != nil {
+		return "", err
+	}
+
+	op := CreateClaimedAccountOperation{
+		Creator:        creator,
+		NewAccountName: newAccountName,
+		Owner:          owner,
+		Active:         active,
+		Posting:        posting,
+		MemoKey:        memoKey,
+		JsonMetadata:   jsonMetadata,
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type AccountCreateOperation struct {
+	Fee            string    `json:"fee"`
+	Creator        string    `json:"creator"`
+	NewAccountName string    `json:"new_account_name"`
+	Owner          Authority `json:"owner"`
+	Active         Authority `json:"active"`
+	Posting        Authority `json:"posting"`
+	MemoKey        string    `json:"memo_key"`
+	JsonMetadata   string    `json:"json_metadata"`
+}
+
+func (o AccountCreateOperation) opName() string {
+	return "account_create"
+}
+
+func (o AccountCreateOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Creator}}
+}
+
+// AccountCreate creates newAccountName with the given keys, paying fee in HIVE.
+// The fee must equal the account creation fee set by the witnesses.
+func (h *HiveRpcNode) AccountCreate(creator string, newAccountName string, fee string, keys *AccountKeys, jsonMetadata string, wif *string) (string, error) {
+	owner, active, posting, memoKey, err := keys.authorities()
+	if err != nil {
+		return "", err
+	}
+
+	op := AccountCreateOperation{
+		Fee:            fee,
+		Creator:        creator,
+		NewAccountName: newAccountName,
+		Owner:          owner,
+		Active:         active,
+		Posting:        posting,
+		MemoKey:        memoKey,
+		JsonMetadata:   jsonMetadata,
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type AccountCreateWithDelegationOperation struct {
+	Fee            string    `json:"fee"`
+	Delegation     string    `json:"delegation"`
+	Creator        string    `json:"creator"`
+	NewAccountName string    `json:"new_account_name"`
+	Owner          Authority `json:"owner"`
+	Active         Authority `json:"active"`
+	Posting        Authority `json:"posting"`
+	MemoKey        string    `json:"memo_key"`
+	JsonMetadata   string    `json:"json_metadata"`
+}
+
+func (o AccountCreateWithDelegationOperation) opName() string {
+	return "account_create_with_delegation"
+}
+
+func (o AccountCreateWithDelegationOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Creator}}
+}
+
+// authorities returns single key authorities and the memo key for a new account.
+func (k *AccountKeys) authorities() (Authority, Authority, Authority, string, error) {
+	if k == nil || k.Owner == nil || k.Active == nil || k.Posting == nil || k.Memo == nil {
+		return Authority{}, Authority{}, Authority{}, "", errors.New("incomplete account keys")
+	}
+
+	return NewKeyAuthority(*k.Owner.GetPublicKeyString()),
+		NewKeyAuthority(*k.Active.GetPublicKeyString()),
+		NewKeyAuthority(*k.Posting.GetPublicKeyString()),
+		*k.Memo.GetPublicKeyString(), nil
+}
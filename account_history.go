@@ -0,0 +1,184 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// maxAccountHistoryLimit is the most entries a node returns per get_account_history call.
+const maxAccountHistoryLimit = 1000
+
+// AccountHistoryFilter returns the operation_filter_low and
+// operation_filter_high bitmasks that select the given operations, named like
+// "transfer" or "transfer_operation". Virtual operations can be selected too.
+// Operation ids below 64 map to bits of the low mask, the others to the high mask.
+func AccountHistoryFilter(opNames ...string) (uint64, uint64, error) {
+	opIds := getHiveOpIds()
+	for name, id := range getHiveVirtualOpIds() {
+		opIds[name] = id
+	}
+
+	var low, high uint64
+	for _, name := range opNames {
+		id, ok := opIds[strings.TrimSuffix(name, "_operation")+"_operation"]
+		if !ok {
+			return 0, 0, errors.New("unknown operation: " + name)
+		}
+		if id < 64 {
+			low |= 1 << id
+		} else {
+			high |= 1 << (id - 64)
+		}
+	}
+	return low, high, nil
+}
+
+// GetAccountHistory returns up to limit entries of account's history, ending
+// with the entry numbered start, oldest first. A start of -1 is the latest
+// entry. With filterLow and filterHigh, as computed by AccountHistoryFilter,
+// only the selected operations are returned; both zero selects all.
+func (h *HiveRpcNode) GetAccountHistory(account string, start int64, limit int, filterLow uint64, filterHigh uint64) ([]types.AccountHistoryEntry, error) {
+	params := []interface{}{account, start, limit}
+	if filterLow != 0 || filterHigh != 0 {
+		params = append(params, filterLow, filterHigh)
+	}
+
+	var query = hrpcQuery{method: "condenser_api.get_account_history", params: params}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []types.AccountHistoryEntry
+	err = json.Unmarshal(res, &history)
+	if err != nil {
+		return nil, err
+	}
+	for i := range history {
+		if op, err := DecodeOperation(history[i].Op); err == nil {
+			history[i].Op = op
+		}
+	}
+	return history, nil
+}
+
+// AccountHistoryIterator walks an account's history backwards, newest entry
+// first, fetching it in pages of up to 1000 entries.
+//
+//	it := hrpc.NewAccountHistoryIterator(account, filterLow, filterHigh).StopAtSequence(lastSeen + 1)
+//	for it.Next() {
+//		entry := it.Entry()
+//	}
+//	if it.Err() != nil { ... }
+type AccountHistoryIterator struct {
+	fetch    func(start int64, limit int) ([]types.AccountHistoryEntry, error)
+	start    int64
+	stopSeq  int64
+	stopTime time.Time
+	page     []types.AccountHistoryEntry
+	entry    types.AccountHistoryEntry
+	done     bool
+	err      error
+}
+
+func (h *HiveRpcNode) NewAccountHistoryIterator(account string, filterLow uint64, filterHigh uint64) *AccountHistoryIterator {
+	return newAccountHistoryIterator(func(start int64, limit int) ([]types.AccountHistoryEntry, error) {
+		return h.GetAccountHistory(account, start, limit, filterLow, filterHigh)
+	})
+}
+
+func newAccountHistoryIterator(fetch func(start int64, limit int) ([]types.AccountHistoryEntry, error)) *AccountHistoryIterator {
+	return &AccountHistoryIterator{fetch: fetch, start: -1}
+}
+
+// StopAtSequence ends the iteration at the entry numbered seq, which is the
+// last entry returned.
+func (it *AccountHistoryIterator) StopAtSequence(seq int64) *AccountHistoryIterator {
+	it.stopSeq = seq
+	return it
+}
+
+// StopAtTime ends the iteration at the first entry older than t.
+func (it *AccountHistoryIterator) StopAtTime(t time.Time) *AccountHistoryIterator {
+	it.stopTime = t.UTC()
+	return it
+}
+
+// Next advances to the next older entry. It returns false when the history
+// is exhausted, a stop condition is reached or a request failed; Err tells
+// the latter apart.
+func (it *AccountHistoryIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done {
+			return false
+		}
+		it.fetchPage()
+	}
+
+	it.entry = it.page[len(it.page)-1]
+	it.page = it.page[:len(it.page)-1]
+
+	if it.entry.Seq < it.stopSeq || it.beforeStopTime(it.entry) {
+		it.page = nil
+		it.done = true
+		return false
+	}
+	return true
+}
+
+// Entry returns the current entry.
+func (it *AccountHistoryIterator) Entry() types.AccountHistoryEntry {
+	return it.entry
+}
+
+// Err returns the error that ended the iteration, if any.
+func (it *AccountHistoryIterator) Err() error {
+	return it.err
+}
+
+func (it *AccountHistoryIterator) fetchPage() {
+	limit := maxAccountHistoryLimit
+	if it.start >= 0 && it.start+1 < int64(limit) {
+		limit = int(it.start + 1)
+	}
+
+	page, err := it.fetch(it.start, limit)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return
+	}
+	if len(page) == 0 {
+		it.done = true
+		return
+	}
+
+	// entries come oldest first; the next page ends just before this one
+	oldest := page[0].Seq
+	if it.start >= 0 && oldest > it.start {
+		it.err = errors.New("account history did not go backwards")
+		it.done = true
+		return
+	}
+	if oldest == 0 {
+		it.done = true
+	}
+	it.start = oldest - 1
+	it.page = page
+}
+
+func (it *AccountHistoryIterator) beforeStopTime(entry types.AccountHistoryEntry) bool {
+	if it.stopTime.IsZero() {
+		return false
+	}
+	timestamp, err := time.Parse(hiveTimeLayout, entry.Timestamp)
+	if err != nil {
+		it.err = err
+		return true
+	}
+	return timestamp.Before(it.stopTime)
+}
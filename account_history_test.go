@@ -0,0 +1,129 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestAccountHistoryFilter(t *testing.T) {
+	low, high, err := AccountHistoryFilter("transfer", "recurrent_transfer_operation", "fill_order", "producer_reward", "fill_recurrent_transfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if low != 1<<2|1<<49|1<<57 {
+		t.Errorf("got low %b", low)
+	}
+	if high != 1<<0|1<<19 {
+		t.Errorf("got high %b", high)
+	}
+
+	_, _, err = AccountHistoryFilter("no_such_op")
+	if err == nil {
+		t.Error("expected an error for an unknown operation")
+	}
+}
+
+func TestAccountHistoryEntryJson(t *testing.T) {
+	data := `[[41,{"trx_id":"aa","block":80000000,"trx_in_block":3,"op_in_trx":0,"virtual_op":false,"timestamp":"2023-10-23T10:00:00","op":["transfer",{"from":"alice","to":"bob","amount":"1.000 HIVE","memo":""}]}]]`
+
+	var history []types.AccountHistoryEntry
+	err := json.Unmarshal([]byte(data), &history)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	history[0].Op, err = DecodeOperation(history[0].Op)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := TransferOperation{From: "alice", To: "bob", Amount: "1.000 HIVE", Memo: ""}
+	if len(history) != 1 || history[0].Seq != 41 || history[0].TrxId != "aa" || history[0].Op.Value != expected {
+		t.Error("got", history)
+	}
+}
+
+// fakeHistory serves a history of count entries, one every minute from
+// 2023-01-01, the way get_account_history pages it.
+type fakeHistory struct {
+	count  int64
+	limits []int
+	err    error
+}
+
+func (f *fakeHistory) fetch(start int64, limit int) ([]types.AccountHistoryEntry, error) {
+	f.limits = append(f.limits, limit)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if start < 0 || start >= f.count {
+		start = f.count - 1
+	}
+	if int64(limit) > start+1 {
+		return nil, errors.New("limit must not exceed start + 1")
+	}
+
+	var page []types.AccountHistoryEntry
+	for seq := start - int64(limit) + 1; seq <= start; seq++ {
+		timestamp := time.Date(2023, 1, 1, 0, int(seq), 0, 0, time.UTC).Format(hiveTimeLayout)
+		page = append(page, types.AccountHistoryEntry{Seq: seq, OperationObject: types.OperationObject{Timestamp: timestamp}})
+	}
+	return page, nil
+}
+
+func collectHistory(it *AccountHistoryIterator) []int64 {
+	var seqs []int64
+	for it.Next() {
+		seqs = append(seqs, it.Entry().Seq)
+	}
+	return seqs
+}
+
+func TestAccountHistoryIterator(t *testing.T) {
+	history := &fakeHistory{count: 2500}
+	it := newAccountHistoryIterator(history.fetch)
+	seqs := collectHistory(it)
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+
+	if len(seqs) != 2500 {
+		t.Fatal("got", len(seqs), "entries")
+	}
+	for i, seq := range seqs {
+		if seq != int64(2499-i) {
+			t.Fatal("entry", i, "has seq", seq)
+		}
+	}
+	if len(history.limits) != 3 || history.limits[0] != 1000 || history.limits[1] != 1000 || history.limits[2] != 500 {
+		t.Error("got limits", history.limits)
+	}
+}
+
+func TestAccountHistoryIteratorStops(t *testing.T) {
+	history := &fakeHistory{count: 2500}
+	seqs := collectHistory(newAccountHistoryIterator(history.fetch).StopAtSequence(1200))
+	if len(seqs) != 1300 || seqs[len(seqs)-1] != 1200 {
+		t.Error("got", len(seqs), "entries ending with", seqs[len(seqs)-1])
+	}
+	if len(history.limits) != 2 {
+		t.Error("expected to stop paging after the stop sequence, got", history.limits)
+	}
+
+	history = &fakeHistory{count: 2500}
+	stopTime := time.Date(2023, 1, 1, 0, 2000, 0, 0, time.UTC)
+	seqs = collectHistory(newAccountHistoryIterator(history.fetch).StopAtTime(stopTime))
+	if len(seqs) != 500 || seqs[len(seqs)-1] != 2000 {
+		t.Error("got", len(seqs), "entries ending with", seqs[len(seqs)-1])
+	}
+
+	history = &fakeHistory{count: 2500, err: errors.New("node down")}
+	it := newAccountHistoryIterator(history.fetch)
+	if it.Next() || it.Err() == nil {
+		t.Error("expected the fetch error")
+	}
+}
@@ -0,0 +1,231 @@
+package hivego
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type AccountUpdateOperation struct {
+	Account      string     `json:"account"`
+	Owner        *Authority `json:"owner,omitempty"`
+	Active       *Authority `json:"active,omitempty"`
+	Posting      *Authority `json:"posting,omitempty"`
+	MemoKey      string     `json:"memo_key"`
+	JsonMetadata string     `json:"json_metadata"`
+}
+
+func (o AccountUpdateOperation) opName() string {
+	return "account_update"
+}
+
+func (o AccountUpdateOperation) requiredAuths() RequiredAuthorities {
+	if o.Owner != nil {
+		return RequiredAuthorities{Owner: []string{o.Account}}
+	}
+	return RequiredAuthorities{Active: []string{o.Account}}
+}
+
+type AccountUpdate2Operation struct {
+	Account             string     `json:"account"`
+	Owner               *Authority `json:"owner,omitempty"`
+	Active              *Authority `json:"active,omitempty"`
+	Posting             *Authority `json:"posting,omitempty"`
+	MemoKey             *string    `json:"memo_key,omitempty"`
+	JsonMetadata        string     `json:"json_metadata"`
+	PostingJsonMetadata string     `json:"posting_json_metadata"`
+}
+
+func (o AccountUpdate2Operation) opName() string {
+	return "account_update2"
+}
+
+// requiredAuths follows the chain: changing the owner needs owner, changing any
+// other authority or the memo key needs active, and metadata alone needs posting.
+func (o AccountUpdate2Operation) requiredAuths() RequiredAuthorities {
+	if o.Owner != nil {
+		return RequiredAuthorities{Owner: []string{o.Account}}
+	}
+	if o.Active != nil || o.Posting != nil || o.MemoKey != nil {
+		return RequiredAuthorities{Active: []string{o.Account}}
+	}
+	return RequiredAuthorities{Posting: []string{o.Account}}
+}
+
+// UpdateAccountMetadata sets the json_metadata and posting_json_metadata of
+// account. Empty values are left unchanged by the chain. Only the posting key
+// is needed.
+func (h *HiveRpcNode) UpdateAccountMetadata(account string, jsonMetadata string, postingJsonMetadata string, wif *string) (string, error) {
+	op := AccountUpdate2Operation{
+		Account:             account,
+		JsonMetadata:        jsonMetadata,
+		PostingJsonMetadata: postingJsonMetadata,
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// PrepareKeyRotation builds an account_update that replaces every key of
+// account with the keys in keys. Account auths, such as posting authority
+// granted to dApps, and the thresholds of the current authorities are kept.
+// An authority held by several keys, or by a key weighted below its
+// threshold, such as a 2-of-3 multisig, would become controlled by a single
+// key, so its replacement must be given in replacements, which is used as is.
+func (h *HiveRpcNode) PrepareKeyRotation(account string, keys *AccountKeys, replacements map[AuthorityLevel]Authority) (AccountUpdateOperation, error) {
+	accountData, err := h.getAccountData(account)
+	if err != nil {
+		return AccountUpdateOperation{}, err
+	}
+
+	return keyRotation(accountData, keys, replacements)
+}
+
+// RotateKeys replaces every key of account with the keys in keys, as after a
+// suspected key leak. It must be signed with the current owner key. The new
+// keys are usually created with GenerateAccountKeys, and must be stored by the
+// caller before calling RotateKeys. Multisig authorities need a replacement in
+// replacements, see PrepareKeyRotation.
+func (h *HiveRpcNode) RotateKeys(account string, keys *AccountKeys, replacements map[AuthorityLevel]Authority, ownerWif *string) (string, error) {
+	op, err := h.PrepareKeyRotation(account, keys, replacements)
+	if err != nil {
+		return "", err
+	}
+
+	return h.broadcast([]HiveOperation{op}, ownerWif)
+}
+
+// keyRotation builds the account_update of PrepareKeyRotation from the
+// current authorities of the account.
+func keyRotation(accountData types.AccountData, keys *AccountKeys, replacements map[AuthorityLevel]Authority) (AccountUpdateOperation, error) {
+	owner, active, posting, memoKey, err := keys.authorities()
+	if err != nil {
+		return AccountUpdateOperation{}, err
+	}
+
+	op := AccountUpdateOperation{Account: accountData.Name, MemoKey: memoKey}
+	for _, auth := range []struct {
+		level   AuthorityLevel
+		current types.Authority
+		newAuth Authority
+		dest    **Authority
+	}{
+		{OwnerAuthority, accountData.Owner, owner, &op.Owner},
+		{ActiveAuthority, accountData.Active, active, &op.Active},
+		{PostingAuthority, accountData.Posting, posting, &op.Posting},
+	} {
+		rotated, ok := replacements[auth.level]
+		if !ok {
+			rotated, err = rotatedAuthority(auth.level, auth.current, auth.newAuth)
+			if err != nil {
+				return AccountUpdateOperation{}, err
+			}
+		}
+		*auth.dest = &rotated
+	}
+	return op, nil
+}
+
+// rotatedAuthority replaces the key auths of current with those of newAuth.
+// The account auths and threshold of current are kept, and each new key is
+// weighted so that it meets the threshold alone. It refuses an authority that
+// no single key meets today, as that would weaken it.
+func rotatedAuthority(level AuthorityLevel, current types.Authority, newAuth Authority) (Authority, error) {
+	rotated := AuthorityFromAccount(current)
+	if rotated.WeightThreshold == 0 {
+		rotated.WeightThreshold = 1
+	}
+	if rotated.WeightThreshold > math.MaxUint16 {
+		return Authority{}, errors.New("authority threshold too high to be met by a single key")
+	}
+	if len(rotated.KeyAuths) > 1 {
+		return Authority{}, errors.New("the " + level.String() + " authority has " + strconv.Itoa(len(rotated.KeyAuths)) + " keys, pass its replacement explicitly")
+	}
+	for _, weight := range rotated.KeyAuths {
+		if uint32(weight) < rotated.WeightThreshold {
+			return Authority{}, errors.New("the " + level.String() + " authority key is weighted below the threshold, pass its replacement explicitly")
+		}
+	}
+
+	rotated.KeyAuths = make(map[string]uint16, len(newAuth.KeyAuths))
+	for key := range newAuth.KeyAuths {
+		rotated.KeyAuths[key] = uint16(rotated.WeightThreshold)
+	}
+	return rotated, nil
+}
+
+// GrantAuthority adds grantee to the posting or active account auths of
+// account with the given weight, or changes its weight if already present, as
+// "authorize app" does in the wallets. Key auths, the threshold and the memo
+// key are kept. It must be signed with the active key of account.
+func (h *HiveRpcNode) GrantAuthority(account string, grantee string, level AuthorityLevel, weight uint16, wif *string) (string, error) {
+	if weight == 0 {
+		return "", errors.New("weight must be greater than zero")
+	}
+
+	return h.changeAccountAuth(account, grantee, level, weight, wif)
+}
+
+// RevokeAuthority removes grantee from the posting or active account auths of
+// account, leaving the rest of the authority unchanged.
+func (h *HiveRpcNode) RevokeAuthority(account string, grantee string, level AuthorityLevel, wif *string) (string, error) {
+	return h.changeAccountAuth(account, grantee, level, 0, wif)
+}
+
+func (h *HiveRpcNode) changeAccountAuth(account string, grantee string, level AuthorityLevel, weight uint16, wif *string) (string, error) {
+	accountData, err := h.getAccountData(account)
+	if err != nil {
+		return "", err
+	}
+
+	op, err := accountAuthUpdate(accountData, grantee, level, weight)
+	if err != nil {
+		return "", err
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// accountAuthUpdate builds the account_update that sets the weight of grantee
+// in the account auths at level, removing it when weight is zero.
+func accountAuthUpdate(accountData types.AccountData, grantee string, level AuthorityLevel, weight uint16) (AccountUpdateOperation, error) {
+	var current types.Authority
+	switch level {
+	case PostingAuthority:
+		current = accountData.Posting
+	case ActiveAuthority:
+		current = accountData.Active
+	default:
+		return AccountUpdateOperation{}, errors.New("only posting and active authority can be granted")
+	}
+
+	auth := AuthorityFromAccount(current)
+	if weight == 0 {
+		if _, ok := auth.AccountAuths[grantee]; !ok {
+			return AccountUpdateOperation{}, errors.New(grantee + " has no " + level.String() + " authority over " + accountData.Name)
+		}
+		delete(auth.AccountAuths, grantee)
+	} else {
+		auth.AccountAuths[grantee] = weight
+	}
+
+	var total uint32
+	for _, w := range auth.AccountAuths {
+		total += uint32(w)
+	}
+	for _, w := range auth.KeyAuths {
+		total += uint32(w)
+	}
+	if total < auth.WeightThreshold {
+		return AccountUpdateOperation{}, errors.New("change would leave the " + level.String() + " authority of " + accountData.Name + " below its threshold")
+	}
+
+	op := AccountUpdateOperation{Account: accountData.Name, MemoKey: accountData.MemoKey}
+	if level == PostingAuthority {
+		op.Posting = &auth
+	} else {
+		op.Active = &auth
+	}
+	return op, nil
+}
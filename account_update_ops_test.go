@@ -0,0 +1,139 @@
+package hivego
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestRotatedAuthority(t *testing.T) {
+	var current types.Authority
+	err := json.Unmarshal([]byte(`{"weight_threshold":2,"account_auths":[["app",2]],"key_auths":[["STM_old",2]]}`), &current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := rotatedAuthority(ActiveAuthority, current, NewKeyAuthority("STM_new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rotated.WeightThreshold != 2 {
+		t.Error("Expected threshold 2, got", rotated.WeightThreshold)
+	}
+	if len(rotated.AccountAuths) != 1 || rotated.AccountAuths["app"] != 2 {
+		t.Error("Expected account auths to be kept, got", rotated.AccountAuths)
+	}
+	if len(rotated.KeyAuths) != 1 || rotated.KeyAuths["STM_new"] != 2 {
+		t.Error("Expected only the new key with weight 2, got", rotated.KeyAuths)
+	}
+
+	var weak types.Authority
+	err = json.Unmarshal([]byte(`{"weight_threshold":2,"account_auths":[["app",1]],"key_auths":[["STM_old",1]]}`), &weak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rotatedAuthority(ActiveAuthority, weak, NewKeyAuthority("STM_new"))
+	if err == nil {
+		t.Error("Expected an error for a key weighted below the threshold")
+	}
+}
+
+func TestKeyRotationMultisig(t *testing.T) {
+	var accountData types.AccountData
+	err := json.Unmarshal([]byte(`{
+		"name": "treasury",
+		"owner": {"weight_threshold":1,"account_auths":[],"key_auths":[["STM_owner",1]]},
+		"active": {"weight_threshold":2,"account_auths":[],"key_auths":[["STM_a",1],["STM_b",1],["STM_c",1]]},
+		"posting": {"weight_threshold":1,"account_auths":[],"key_auths":[["STM_posting",1]]},
+		"memo_key": "STM_memo"
+	}`), &accountData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := AccountKeysFromPassword("treasury", "password")
+
+	_, err = keyRotation(accountData, keys, nil)
+	if err == nil || !strings.Contains(err.Error(), "active") {
+		t.Fatal("Expected an error for the 2-of-3 active authority, got", err)
+	}
+
+	active := Authority{WeightThreshold: 2, AccountAuths: map[string]uint16{}, KeyAuths: map[string]uint16{"STM_x": 1, "STM_y": 1, "STM_z": 1}}
+	op, err := keyRotation(accountData, keys, map[AuthorityLevel]Authority{ActiveAuthority: active})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*op.Active, active) {
+		t.Error("Expected the given active authority, got", op.Active)
+	}
+	owner := *keys.Owner.GetPublicKeyString()
+	if op.Account != "treasury" || len(op.Owner.KeyAuths) != 1 || op.Owner.KeyAuths[owner] != 1 {
+		t.Error("Expected the owner to be rotated to the new key, got", op.Owner)
+	}
+	if op.MemoKey != *keys.Memo.GetPublicKeyString() {
+		t.Error("Expected the new memo key, got", op.MemoKey)
+	}
+}
+
+func TestAccountUpdate2RequiredAuths(t *testing.T) {
+	auth := NewKeyAuthority("STM_new")
+	memoKey := "STM_memo"
+
+	tests := []struct {
+		op       AccountUpdate2Operation
+		expected AuthorityLevel
+	}{
+		{AccountUpdate2Operation{Account: "alice", PostingJsonMetadata: "{}"}, PostingAuthority},
+		{AccountUpdate2Operation{Account: "alice", Posting: &auth}, ActiveAuthority},
+		{AccountUpdate2Operation{Account: "alice", MemoKey: &memoKey}, ActiveAuthority},
+		{AccountUpdate2Operation{Account: "alice", Owner: &auth, Active: &auth}, OwnerAuthority},
+	}
+
+	for _, test := range tests {
+		for _, required := range test.op.requiredAuths().byLevel() {
+			needed := len(required.accounts) == 1 && required.accounts[0] == "alice"
+			if needed != (required.level == test.expected) {
+				t.Error("Expected alice to need only", test.expected, "authority, got", test.op.requiredAuths())
+			}
+		}
+	}
+}
+
+func TestAccountAuthUpdate(t *testing.T) {
+	var accountData types.AccountData
+	err := json.Unmarshal([]byte(`{"name":"alice","memo_key":"STM_memo",
+		"active":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_active",1]]},
+		"posting":{"weight_threshold":1,"account_auths":[["peakd.app",1]],"key_auths":[["STM_posting",1]]}}`), &accountData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := accountAuthUpdate(accountData, "ourapp", PostingAuthority, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Owner != nil || op.Active != nil || op.Posting == nil || op.MemoKey != "STM_memo" {
+		t.Fatal("Expected only the posting authority and the current memo key, got", op)
+	}
+	if op.Posting.AccountAuths["ourapp"] != 1 || op.Posting.AccountAuths["peakd.app"] != 1 || op.Posting.KeyAuths["STM_posting"] != 1 {
+		t.Error("Expected ourapp to be added to the posting authority, got", op.Posting)
+	}
+
+	op, err = accountAuthUpdate(accountData, "peakd.app", PostingAuthority, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(op.Posting.AccountAuths) != 0 || op.Posting.KeyAuths["STM_posting"] != 1 || op.Posting.WeightThreshold != 1 {
+		t.Error("Expected peakd.app to be removed from the posting authority, got", op.Posting)
+	}
+
+	if _, err = accountAuthUpdate(accountData, "ourapp", ActiveAuthority, 0); err == nil {
+		t.Error("Expected an error revoking an account that was never granted authority")
+	}
+	if _, err = accountAuthUpdate(accountData, "ourapp", OwnerAuthority, 1); err == nil {
+		t.Error("Expected an error granting owner authority")
+	}
+}
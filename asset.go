@@ -0,0 +1,132 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// parseAsset splits a legacy asset string such as "1.000 HIVE" into its
+// amount in the smallest unit, its precision and its symbol.
+func parseAsset(asset string) (int64, int, string, error) {
+	parts := strings.Split(asset, " ")
+	if len(parts) != 2 {
+		return 0, 0, "", errors.New("invalid asset format: " + asset)
+	}
+
+	amountStr, symbol := parts[0], parts[1]
+	precision := 3
+	if symbol == "VESTS" {
+		precision = 6
+	}
+
+	// Handle decimal parsing without floating points
+	parts = strings.Split(amountStr, ".")
+	if len(parts) > 2 {
+		return 0, 0, "", errors.New("invalid amount format: " + amountStr)
+	}
+
+	// Pad or truncate decimal part to required precision
+	decimalPart := ""
+	if len(parts) > 1 {
+		decimalPart = parts[1]
+	}
+	if len(decimalPart) > precision {
+		decimalPart = decimalPart[:precision]
+	} else {
+		decimalPart += strings.Repeat("0", precision-len(decimalPart))
+	}
+
+	// Combine whole and decimal parts
+	fullNumber := parts[0] + decimalPart
+	amount, err := strconv.ParseInt(fullNumber, 10, 64)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	return amount, precision, symbol, nil
+}
+
+// formatAsset formats an amount in the smallest unit as a legacy asset string.
+func formatAsset(amount int64, precision int, symbol string) string {
+	return types.Asset{Amount: amount, Precision: precision, Symbol: symbol}.String()
+}
+
+// naiToLegacyAsset formats an appbase {amount, precision, nai} asset as a legacy "1.000 HIVE" string.
+func naiToLegacyAsset(amount string, precision int, nai string) (string, error) {
+	symbol, ok := types.NaiSymbols[nai]
+	if !ok {
+		return "", errors.New("unknown asset nai: " + nai)
+	}
+
+	value, err := strconv.ParseInt(amount, 10, 64)
+	if err != nil {
+		return "", err
+	}
+
+	return formatAsset(value, precision, symbol), nil
+}
+
+// legacyAssetsFromAppbase replaces appbase asset objects nested anywhere in
+// v with legacy asset strings, so that appbase operation values decode into
+// the operation types of this package.
+func legacyAssetsFromAppbase(v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if amount, ok := val["amount"].(string); ok && len(val) == 3 {
+			nai, okN := val["nai"].(string)
+			if precision, okP := jsonInt(val["precision"]); okP && okN {
+				return naiToLegacyAsset(amount, precision, nai)
+			}
+		}
+
+		converted := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			c, err := legacyAssetsFromAppbase(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[k] = c
+		}
+		return converted, nil
+	case []interface{}:
+		converted := make([]interface{}, len(val))
+		for i, item := range val {
+			c, err := legacyAssetsFromAppbase(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = c
+		}
+		return converted, nil
+	default:
+		return v, nil
+	}
+}
+
+// jsonInt reads an integer decoded from JSON either as a float64 or as a json.Number.
+func jsonInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int(n), true
+	case json.Number:
+		i, err := strconv.Atoi(n.String())
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// checkAssetSymbol returns an error unless asset is a legacy asset string in symbol.
+func checkAssetSymbol(asset string, symbol string) error {
+	parts := strings.Split(asset, " ")
+	if len(parts) != 2 {
+		return errors.New("invalid asset format: " + asset)
+	}
+	if parts[1] != symbol {
+		return errors.New("expected a " + symbol + " amount, got " + asset)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package hivego
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestFormatAsset(t *testing.T) {
+	tests := []struct {
+		amount    int64
+		precision int
+		symbol    string
+		expected  string
+	}{
+		{1500, 3, "HBD", "1.500 HBD"},
+		{5, 3, "HIVE", "0.005 HIVE"},
+		{-1234567, 6, "VESTS", "-1.234567 VESTS"},
+		{0, 6, "VESTS", "0.000000 VESTS"},
+	}
+
+	for _, test := range tests {
+		got := formatAsset(test.amount, test.precision, test.symbol)
+		if got != test.expected {
+			t.Error("Expected", test.expected, "got", got)
+		}
+	}
+}
+
+func TestNaiToLegacyAsset(t *testing.T) {
+	got, err := naiToLegacyAsset("123456", 6, "@@000000037")
+	expected := "0.123456 VESTS"
+	if err != nil || got != expected {
+		t.Error("Expected", expected, "got", got, err)
+	}
+}
+
+func TestConvertVesting(t *testing.T) {
+	fund, shares := "1000.000 HIVE", "2000000.000000 VESTS"
+
+	got, err := convertVesting("1.234 HIVE", "HIVE", shares, fund)
+	expected := "2468.000000 VESTS"
+	if err != nil || got != expected {
+		t.Error("Expected", expected, "got", got, err)
+	}
+
+	got, err = convertVesting("2468.000001 VESTS", "VESTS", fund, shares)
+	expected = "1.234 HIVE"
+	if err != nil || got != expected {
+		t.Error("Expected", expected, "got", got, err)
+	}
+
+	if _, err = convertVesting("1.000 HBD", "HIVE", shares, fund); err == nil {
+		t.Error("Expected an error for an HBD amount")
+	}
+
+	if _, err = convertVesting("9000000000.000 HIVE", "HIVE", "9000000000000.000000 VESTS", "0.001 HIVE"); err == nil {
+		t.Error("Expected an overflow error")
+	}
+}
+
+func TestAssetUnmarshalJSON(t *testing.T) {
+	var ticker types.Ticker
+	js := `{"latest":"0.25","hive_volume":{"amount":"123456","precision":3,"nai":"@@000000021"},"hbd_volume":"30.864 HBD"}`
+	if err := json.Unmarshal([]byte(js), &ticker); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ticker.HiveVolume.String(); got != "123.456 HIVE" {
+		t.Error("Expected 123.456 HIVE got", got)
+	}
+	if got := ticker.HbdVolume; got.Amount != 30864 || got.Precision != 3 || got.Symbol != "HBD" {
+		t.Error("Expected 30864 HBD at precision 3 got", got)
+	}
+}
+
+func TestEstimateCollateralizedConvert(t *testing.T) {
+	minPrice := types.Price{Base: types.Asset{Amount: 250, Precision: 3, Symbol: "HBD"}, Quote: types.Asset{Amount: 1000, Precision: 3, Symbol: "HIVE"}}
+
+	got, err := estimateCollateralizedConvert("210.000 HIVE", minPrice)
+	expected := "25.000 HBD"
+	if err != nil || got != expected {
+		t.Error("Expected", expected, "got", got, err)
+	}
+
+	extreme := types.Price{Base: types.Asset{Amount: 1 << 40, Precision: 3, Symbol: "HBD"}, Quote: types.Asset{Amount: 1, Precision: 3, Symbol: "HIVE"}}
+	if _, err = estimateCollateralizedConvert("9000000000.000 HIVE", extreme); err == nil {
+		t.Error("Expected an overflow error")
+	}
+}
+
+func TestFeedHistoryJson(t *testing.T) {
+	data := `{"id":0,"current_median_history":{"base":"0.250 HBD","quote":"1.000 HIVE"},"market_median_history":{"base":"0.250 HBD","quote":"1.000 HIVE"},"current_min_history":{"base":"0.230 HBD","quote":"1.000 HIVE"},"current_max_history":{"base":"0.270 HBD","quote":"1.000 HIVE"},"price_history":[{"base":"0.240 HBD","quote":"1.000 HIVE"}]}`
+
+	var history types.FeedHistory
+	err := json.Unmarshal([]byte(data), &history)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history.CurrentMinHistory.Base.Amount != 230 || history.CurrentMedianHistory.Base.Amount != 250 || len(history.PriceHistory) != 1 {
+		t.Error("got", history)
+	}
+
+	got, err := estimateCollateralizedConvert("210.000 HIVE", history.CurrentMinHistory)
+	expected := "23.000 HBD"
+	if err != nil || got != expected {
+		t.Error("Expected", expected, "got", got, err)
+	}
+}
+
+func TestPriceConversion(t *testing.T) {
+	price := Price{Base: "0.250 HBD", Quote: "1.000 HIVE"}
+	converted, err := price.Types()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := types.Price{Base: types.Asset{Amount: 250, Precision: 3, Symbol: "HBD"}, Quote: types.Asset{Amount: 1000, Precision: 3, Symbol: "HIVE"}}
+	if converted != expected {
+		t.Error("got", converted)
+	}
+	if PriceFromTypes(converted) != price {
+		t.Error("got", PriceFromTypes(converted), "expected", price)
+	}
+
+	_, err = Price{Base: "0.250", Quote: "1.000 HIVE"}.Types()
+	if err == nil {
+		t.Error("expected an error for an invalid base")
+	}
+}
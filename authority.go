@@ -0,0 +1,496 @@
+package hivego
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// maxSigCheckDepth mirrors HIVE_MAX_SIG_CHECK_DEPTH, the depth to which
+// account auths are followed when checking an authority.
+const maxSigCheckDepth = 2
+
+type AuthorityLevel int
+
+const (
+	PostingAuthority AuthorityLevel = iota
+	ActiveAuthority
+	OwnerAuthority
+)
+
+func (l AuthorityLevel) String() string {
+	switch l {
+	case PostingAuthority:
+		return "posting"
+	case ActiveAuthority:
+		return "active"
+	case OwnerAuthority:
+		return "owner"
+	}
+	return "unknown"
+}
+
+// RequiredAuthorities lists the accounts whose owner, active or posting
+// authority must approve a transaction, and any other authorities, such as
+// a witness signing key, that are not tied to an account.
+type RequiredAuthorities struct {
+	Owner   []string
+	Active  []string
+	Posting []string
+	Other   []types.Authority
+}
+
+func (r *RequiredAuthorities) merge(other RequiredAuthorities) {
+	r.Owner = append(r.Owner, other.Owner...)
+	r.Active = append(r.Active, other.Active...)
+	r.Posting = append(r.Posting, other.Posting...)
+	r.Other = append(r.Other, other.Other...)
+}
+
+func uniqueSorted(accounts []string) []string {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(accounts))
+	var unique []string
+	for _, account := range accounts {
+		if !seen[account] {
+			seen[account] = true
+			unique = append(unique, account)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// RequiredAuthorities returns the authorities that must sign the transaction,
+// aggregated over its operations.
+func (t *HiveTransaction) RequiredAuthorities() RequiredAuthorities {
+	var required RequiredAuthorities
+	for _, op := range t.Operations {
+		required.merge(op.requiredAuths())
+	}
+
+	required.Owner = uniqueSorted(required.Owner)
+	required.Active = uniqueSorted(required.Active)
+	required.Posting = uniqueSorted(required.Posting)
+	return required
+}
+
+// Authority is an owner, active or posting authority as written to the
+// chain. Account and key auths are kept in maps and sorted when serialized.
+type Authority struct {
+	WeightThreshold uint32
+	AccountAuths    map[string]uint16
+	KeyAuths        map[string]uint16
+}
+
+// NewKeyAuthority returns an authority satisfied by a single public key.
+func NewKeyAuthority(pubKey string) Authority {
+	return Authority{WeightThreshold: 1, AccountAuths: map[string]uint16{}, KeyAuths: map[string]uint16{pubKey: 1}}
+}
+
+// AuthorityFromAccount converts an authority read from GetAccount into an Authority.
+func AuthorityFromAccount(auth types.Authority) Authority {
+	a := Authority{WeightThreshold: uint32(auth.WeightThreshold), AccountAuths: map[string]uint16{}, KeyAuths: map[string]uint16{}}
+	for account, weight := range auth.AccountWeights() {
+		a.AccountAuths[account] = uint16(weight)
+	}
+	for key, weight := range auth.KeyWeights() {
+		a.KeyAuths[key] = uint16(weight)
+	}
+	return a
+}
+
+// toAccountAuthority converts the authority into the read model used for authority checks.
+func (a Authority) toAccountAuthority() types.Authority {
+	auth := types.Authority{WeightThreshold: int(a.WeightThreshold), AccountAuths: [][]interface{}{}, KeyAuths: [][]interface{}{}}
+	for _, account := range a.sortedAccounts() {
+		auth.AccountAuths = append(auth.AccountAuths, []interface{}{account, a.AccountAuths[account]})
+	}
+	for key, weight := range a.KeyAuths {
+		auth.KeyAuths = append(auth.KeyAuths, []interface{}{key, weight})
+	}
+	return auth
+}
+
+func (a Authority) sortedAccounts() []string {
+	accounts := make([]string, 0, len(a.AccountAuths))
+	for account := range a.AccountAuths {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+// sortedKeys returns the keys in the order of their binary form, which is the order the chain stores them in.
+func (a Authority) sortedKeys() ([]string, error) {
+	type keyWithBytes struct {
+		key string
+		b   []byte
+	}
+
+	var keys []keyWithBytes
+	for key := range a.KeyAuths {
+		b, err := publicKeyBytes(key)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, keyWithBytes{key, b})
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i].b, keys[j].b) < 0 })
+
+	sorted := make([]string, len(keys))
+	for i, k := range keys {
+		sorted[i] = k.key
+	}
+	return sorted, nil
+}
+
+func (a Authority) MarshalJSON() ([]byte, error) {
+	keys, err := a.sortedKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	accountAuths := [][]interface{}{}
+	for _, account := range a.sortedAccounts() {
+		accountAuths = append(accountAuths, []interface{}{account, a.AccountAuths[account]})
+	}
+	keyAuths := [][]interface{}{}
+	for _, key := range keys {
+		keyAuths = append(keyAuths, []interface{}{key, a.KeyAuths[key]})
+	}
+
+	return json.Marshal(struct {
+		WeightThreshold uint32          `json:"weight_threshold"`
+		AccountAuths    [][]interface{} `json:"account_auths"`
+		KeyAuths        [][]interface{} `json:"key_auths"`
+	}{a.WeightThreshold, accountAuths, keyAuths})
+}
+
+func (a *Authority) UnmarshalJSON(data []byte) error {
+	var auth types.Authority
+	err := json.Unmarshal(data, &auth)
+	if err != nil {
+		return err
+	}
+
+	*a = AuthorityFromAccount(auth)
+	return nil
+}
+
+type authorityResolver struct {
+	node     *HiveRpcNode
+	accounts map[string]types.AccountData
+}
+
+func newAuthorityResolver(node *HiveRpcNode) *authorityResolver {
+	return &authorityResolver{node: node, accounts: make(map[string]types.AccountData)}
+}
+
+func (r *authorityResolver) authority(account string, level AuthorityLevel) (types.Authority, error) {
+	accountData, ok := r.accounts[account]
+	if !ok {
+		var err error
+		accountData, err = r.node.getAccountData(account)
+		if err != nil {
+			return types.Authority{}, err
+		}
+		r.accounts[account] = accountData
+	}
+
+	switch level {
+	case OwnerAuthority:
+		return accountData.Owner, nil
+	case ActiveAuthority:
+		return accountData.Active, nil
+	default:
+		return accountData.Posting, nil
+	}
+}
+
+// signState follows the chain's sign_state: nested account auths are
+// resolved at nestedLevel, which is posting when checking posting authority
+// and active otherwise.
+type signState struct {
+	resolver    *authorityResolver
+	keys        map[string]bool
+	nestedLevel AuthorityLevel
+	approvedBy  map[string]bool
+}
+
+func (s *signState) checkAuthority(auth types.Authority, depth int) (bool, error) {
+	totalWeight := 0
+	for key, weight := range auth.KeyWeights() {
+		if s.keys[key] {
+			totalWeight += weight
+			if totalWeight >= auth.WeightThreshold {
+				return true, nil
+			}
+		}
+	}
+
+	for account, weight := range auth.AccountWeights() {
+		if !s.approvedBy[account] {
+			if depth == maxSigCheckDepth {
+				continue
+			}
+
+			nested, err := s.resolver.authority(account, s.nestedLevel)
+			if err != nil {
+				return false, err
+			}
+			ok, err := s.checkAuthority(nested, depth+1)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				continue
+			}
+			s.approvedBy[account] = true
+		}
+
+		totalWeight += weight
+		if totalWeight >= auth.WeightThreshold {
+			return true, nil
+		}
+	}
+
+	return totalWeight >= auth.WeightThreshold, nil
+}
+
+// checkAccount reports whether the account's authority at level, or a
+// higher one, is satisfied.
+func (s *signState) checkAccount(account string, level AuthorityLevel) (bool, error) {
+	for l := level; l <= OwnerAuthority; l++ {
+		auth, err := s.resolver.authority(account, l)
+		if err != nil {
+			return false, err
+		}
+		ok, err := s.checkAuthority(auth, 0)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func newSignState(resolver *authorityResolver, keys []string, level AuthorityLevel) *signState {
+	keySet := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		keySet[key] = true
+	}
+
+	nestedLevel := ActiveAuthority
+	if level == PostingAuthority {
+		nestedLevel = PostingAuthority
+	}
+
+	return &signState{resolver: resolver, keys: keySet, nestedLevel: nestedLevel, approvedBy: make(map[string]bool)}
+}
+
+// HasAuthority reports whether the given public keys satisfy the account's
+// authority at level. As on chain, a higher authority also satisfies a lower one.
+func (h *HiveRpcNode) HasAuthority(account string, level AuthorityLevel, keys []string) (bool, error) {
+	return newSignState(newAuthorityResolver(h), keys, level).checkAccount(account, level)
+}
+
+type levelAccounts struct {
+	level    AuthorityLevel
+	accounts []string
+}
+
+func (r RequiredAuthorities) byLevel() []levelAccounts {
+	return []levelAccounts{
+		{OwnerAuthority, r.Owner},
+		{ActiveAuthority, r.Active},
+		{PostingAuthority, r.Posting},
+	}
+}
+
+// VerifyAuthorities checks that the given public keys satisfy all required
+// authorities and returns an error naming the first one that is not met.
+func (h *HiveRpcNode) VerifyAuthorities(required RequiredAuthorities, keys []string) error {
+	return verifyAuthorities(newAuthorityResolver(h), required, keys)
+}
+
+// VerifyTransactionAuthorities checks that the signatures on the transaction
+// satisfy the authorities required by its operations.
+func (h *HiveRpcNode) VerifyTransactionAuthorities(tx *HiveTransaction) error {
+	keys, err := tx.SignerKeys()
+	if err != nil {
+		return err
+	}
+
+	return h.VerifyAuthorities(tx.RequiredAuthorities(), keys)
+}
+
+// SignRequired signs the transaction with those of the given WIFs it needs.
+// WIFs of keys that belong to no required authority are left out, and so are
+// keys the required authorities are met without, as the chain rejects
+// unnecessary signatures. Nothing is signed unless the existing and the
+// selected signatures together satisfy all required authorities.
+func (h *HiveRpcNode) SignRequired(tx *HiveTransaction, wifs ...*string) error {
+	signed, err := tx.SignerKeys()
+	if err != nil {
+		return err
+	}
+
+	selected, err := selectSigningWifs(newAuthorityResolver(h), tx.RequiredAuthorities(), signed, wifs)
+	if err != nil {
+		return err
+	}
+	return tx.SignMultiple(selected...)
+}
+
+// selectSigningWifs returns the WIFs, among wifs, whose signatures the
+// required authorities need in addition to the keys that already signed.
+func selectSigningWifs(resolver *authorityResolver, required RequiredAuthorities, signed []string, wifs []*string) ([]*string, error) {
+	relevant := make(map[string]bool)
+	for _, l := range required.byLevel() {
+		for _, account := range l.accounts {
+			err := resolver.collectKeys(account, l.level, relevant)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, auth := range required.Other {
+		err := resolver.collectAuthorityKeys(auth, ActiveAuthority, 0, relevant)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range signed {
+		seen[key] = true
+	}
+
+	var keys []string
+	var selected []*string
+	for _, wif := range wifs {
+		keyPair, err := KeyPairFromWif(*wif)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey := *keyPair.GetPublicKeyString()
+		if !relevant[pubKey] || seen[pubKey] {
+			continue
+		}
+		seen[pubKey] = true
+		keys = append(keys, pubKey)
+		selected = append(selected, wif)
+	}
+
+	withSigned := func(keys []string) []string {
+		return append(append([]string{}, signed...), keys...)
+	}
+
+	err := verifyAuthorities(resolver, required, withSigned(keys))
+	if err != nil {
+		return nil, err
+	}
+
+	// drop each key the authorities are still met without
+	for i := 0; i < len(keys); {
+		without := append(append([]string{}, keys[:i]...), keys[i+1:]...)
+		if verifyAuthorities(resolver, required, withSigned(without)) != nil {
+			i++
+			continue
+		}
+		keys = without
+		selected = append(selected[:i], selected[i+1:]...)
+	}
+
+	return selected, nil
+}
+
+// collectKeys adds every key that can contribute to the account's authority
+// at level, including higher authorities and nested account auths, to keys.
+func (r *authorityResolver) collectKeys(account string, level AuthorityLevel, keys map[string]bool) error {
+	nestedLevel := ActiveAuthority
+	if level == PostingAuthority {
+		nestedLevel = PostingAuthority
+	}
+
+	for l := level; l <= OwnerAuthority; l++ {
+		auth, err := r.authority(account, l)
+		if err != nil {
+			return err
+		}
+
+		err = r.collectAuthorityKeys(auth, nestedLevel, 0, keys)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *authorityResolver) collectAuthorityKeys(auth types.Authority, nestedLevel AuthorityLevel, depth int, keys map[string]bool) error {
+	for key := range auth.KeyWeights() {
+		keys[key] = true
+	}
+
+	if depth == maxSigCheckDepth {
+		return nil
+	}
+
+	for account := range auth.AccountWeights() {
+		nested, err := r.authority(account, nestedLevel)
+		if err != nil {
+			return err
+		}
+
+		err = r.collectAuthorityKeys(nested, nestedLevel, depth+1, keys)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyAuthorities(resolver *authorityResolver, required RequiredAuthorities, keys []string) error {
+	if len(required.Posting) > 0 && (len(required.Active) > 0 || len(required.Owner) > 0 || len(required.Other) > 0) {
+		return errors.New("posting authority cannot be combined with active or owner authority in one transaction")
+	}
+
+	if len(required.Other) > 0 {
+		state := newSignState(resolver, keys, ActiveAuthority)
+		for _, auth := range required.Other {
+			ok, err := state.checkAuthority(auth, 0)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.New("missing required authority")
+			}
+		}
+	}
+
+	for _, l := range required.byLevel() {
+		if len(l.accounts) == 0 {
+			continue
+		}
+
+		state := newSignState(resolver, keys, l.level)
+		for _, account := range l.accounts {
+			ok, err := state.checkAccount(account, l.level)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.New("missing " + l.level.String() + " authority of " + account)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package hivego
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func getTestResolver(t *testing.T) *authorityResolver {
+	accountsJs := `[
+		{"name":"treasury",
+			"owner":{"weight_threshold":2,"account_auths":[],"key_auths":[["STM_owner1",1],["STM_owner2",1]]},
+			"active":{"weight_threshold":2,"account_auths":[["signer",1]],"key_auths":[["STM_a",1],["STM_b",1],["STM_c",1]]},
+			"posting":{"weight_threshold":1,"account_auths":[["app",1]],"key_auths":[["STM_posting",1]]}},
+		{"name":"signer",
+			"owner":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_signer_owner",1]]},
+			"active":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_signer_active",1]]},
+			"posting":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_signer_posting",1]]}},
+		{"name":"app",
+			"owner":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_app_owner",1]]},
+			"active":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_app_active",1]]},
+			"posting":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_app_posting",1]]}}
+	]`
+	var accounts []types.AccountData
+	if err := json.Unmarshal([]byte(accountsJs), &accounts); err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := newAuthorityResolver(nil)
+	for _, account := range accounts {
+		resolver.accounts[account.Name] = account
+	}
+	return resolver
+}
+
+func TestCheckAccountAuthority(t *testing.T) {
+	tests := []struct {
+		level    AuthorityLevel
+		keys     []string
+		expected bool
+	}{
+		{ActiveAuthority, []string{"STM_a"}, false},
+		{ActiveAuthority, []string{"STM_a", "STM_c"}, true},
+		{ActiveAuthority, []string{"STM_a", "STM_signer_active"}, true},
+		{ActiveAuthority, []string{"STM_a", "STM_signer_posting"}, false},
+		{ActiveAuthority, []string{"STM_owner1", "STM_owner2"}, true},
+		{PostingAuthority, []string{"STM_app_posting"}, true},
+		{PostingAuthority, []string{"STM_b", "STM_c"}, true},
+		{OwnerAuthority, []string{"STM_a", "STM_b"}, false},
+	}
+
+	for _, test := range tests {
+		state := newSignState(getTestResolver(t), test.keys, test.level)
+		got, err := state.checkAccount("treasury", test.level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Error("Expected", test.expected, "for", test.level, test.keys, "got", got)
+		}
+	}
+}
+
+func TestRequiredAuthorities(t *testing.T) {
+	tx := getTestTx([]HiveOperation{
+		VoteOperation{Voter: "bob", Author: "alice", Permlink: "post", Weight: 100},
+		CustomJsonOperation{RequiredAuths: []string{"carol"}, RequiredPostingAuths: []string{"bob", "alice"}, Id: "id", Json: "{}"},
+		TransferOperation{From: "carol", To: "dave", Amount: "1.000 HIVE"},
+	})
+
+	got := tx.RequiredAuthorities()
+	if len(got.Owner) != 0 {
+		t.Error("Expected no owner authorities, got", got.Owner)
+	}
+	if len(got.Active) != 1 || got.Active[0] != "carol" {
+		t.Error("Expected [carol], got", got.Active)
+	}
+	if len(got.Posting) != 2 || got.Posting[0] != "alice" || got.Posting[1] != "bob" {
+		t.Error("Expected [alice bob], got", got.Posting)
+	}
+}
+
+func TestCollectKeys(t *testing.T) {
+	keys := make(map[string]bool)
+	if err := getTestResolver(t).collectKeys("treasury", ActiveAuthority, keys); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"STM_a", "STM_owner1", "STM_signer_active"} {
+		if !keys[key] {
+			t.Error("Expected", key, "to be collected")
+		}
+	}
+	for _, key := range []string{"STM_posting", "STM_signer_posting", "STM_app_posting"} {
+		if keys[key] {
+			t.Error("Expected", key, "not to be collected")
+		}
+	}
+}
+
+func TestAuthorityJSON(t *testing.T) {
+	auth := Authority{
+		WeightThreshold: 2,
+		AccountAuths:    map[string]uint16{"bob": 1, "alice": 1},
+		KeyAuths: map[string]uint16{
+			"STM7RRMne4FT7R5kr8CKxN5LXrep7qJrqARwza2xXDtiahdtNBxGo": 1,
+			"STM583xumcwp5hfrM2orKYgMGiUaxY6F27UDczN3xveKPYswbdjsg": 2,
+		},
+	}
+
+	got, err := json.Marshal(auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"weight_threshold":2,"account_auths":[["alice",1],["bob",1]],"key_auths":[["STM583xumcwp5hfrM2orKYgMGiUaxY6F27UDczN3xveKPYswbdjsg",2],["STM7RRMne4FT7R5kr8CKxN5LXrep7qJrqARwza2xXDtiahdtNBxGo",1]]}`
+	if string(got) != expected {
+		t.Error("Expected", expected, "got", string(got))
+	}
+
+	var decoded Authority
+	if err = json.Unmarshal(got, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.WeightThreshold != 2 || decoded.AccountAuths["bob"] != 1 || decoded.KeyAuths["STM583xumcwp5hfrM2orKYgMGiUaxY6F27UDczN3xveKPYswbdjsg"] != 2 {
+		t.Error("Authority did not round trip:", decoded)
+	}
+}
+
+func TestSelectSigningWifs(t *testing.T) {
+	var wifs []*string
+	var pubKeys []string
+	for _, seed := range []string{"a", "b", "c", "unrelated"} {
+		keyPair := KeyPairFromSeed(seed)
+		wif := keyPair.GetWif()
+		wifs = append(wifs, &wif)
+		pubKeys = append(pubKeys, *keyPair.GetPublicKeyString())
+	}
+
+	resolver := newAuthorityResolver(nil)
+	resolver.accounts["multisig"] = types.AccountData{
+		Name:    "multisig",
+		Owner:   NewKeyAuthority("STM_owner").toAccountAuthority(),
+		Active:  Authority{WeightThreshold: 2, KeyAuths: map[string]uint16{pubKeys[0]: 1, pubKeys[1]: 1, pubKeys[2]: 1}}.toAccountAuthority(),
+		Posting: NewKeyAuthority("STM_posting").toAccountAuthority(),
+	}
+	required := RequiredAuthorities{Active: []string{"multisig"}}
+
+	selected, err := selectSigningWifs(resolver, required, nil, []*string{wifs[3], wifs[0], wifs[1], wifs[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 2 || selected[0] == wifs[3] || selected[1] == wifs[3] {
+		t.Error("Expected the unrelated and the unneeded key to be left out, got", len(selected), "wifs")
+	}
+
+	selected, err = selectSigningWifs(resolver, required, []string{pubKeys[2]}, wifs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 1 || selected[0] == wifs[2] || selected[0] == wifs[3] {
+		t.Error("Expected one more signature next to the existing one, got", len(selected), "wifs")
+	}
+
+	_, err = selectSigningWifs(resolver, required, nil, []*string{wifs[0], wifs[3]})
+	if err == nil {
+		t.Error("Expected an error when the WIFs cannot satisfy the authority")
+	}
+}
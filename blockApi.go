@@ -122,6 +122,9 @@ func (h *HiveRpcNode) fetchBlockInRange(startBlock, count int) ([]types.Block, e
 	for _, blockRangeResponse := range blockRangeResponses {
 		blocks = append(blocks, blockRangeResponse.Result.Blocks...)
 	}
+	for i := range blocks {
+		decodeBlockOperations(&blocks[i])
+	}
 	return blocks, nil
 }
 
@@ -153,7 +156,9 @@ func (h *HiveRpcNode) fetchBlock(params []types.GetBlockQueryParams) ([]types.Bl
 
 	var blocks []types.Block
 	for _, blockResponse := range blockResponses {
-		blocks = append(blocks, blockResponse.Result.Block)
+		block := blockResponse.Result.Block
+		decodeBlockOperations(&block)
+		blocks = append(blocks, block)
 	}
 	return blocks, nil
 }
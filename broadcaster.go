@@ -1,20 +1,25 @@
 package hivego
 
 import (
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 )
 
-type hiveTransaction struct {
+// HiveTransaction is a transaction ready to be signed and broadcast.
+// It is usually created with a TransactionBuilder.
+type HiveTransaction struct {
 	RefBlockNum    uint16           `json:"ref_block_num"`
 	RefBlockPrefix uint32           `json:"ref_block_prefix"`
 	Expiration     string           `json:"expiration"`
-	Operations     []hiveOperation  `json:"-"`
+	Operations     []HiveOperation  `json:"-"`
 	OperationsJs   [][2]interface{} `json:"operations"`
 	Extensions     []string         `json:"extensions"`
 	Signatures     []string         `json:"signatures"`
 }
 
-func (t *hiveTransaction) generateTrxId() (string, error) {
+func (t *HiveTransaction) generateTrxId() (string, error) {
 	tB, err := serializeTx(*t)
 	if err != nil {
 		return "", err
@@ -24,7 +29,7 @@ func (t *hiveTransaction) generateTrxId() (string, error) {
 	return hex.EncodeToString(digest)[0:40], nil
 }
 
-func (t *hiveTransaction) prepareJson() {
+func (t *HiveTransaction) prepareJson() {
 	var opsContainer [][2]interface{}
 	for _, op := range t.Operations {
 		var opContainer [2]interface{}
@@ -35,37 +40,174 @@ func (t *hiveTransaction) prepareJson() {
 	if t.Extensions == nil {
 		t.Extensions = []string{}
 	}
+	if t.Signatures == nil {
+		t.Signatures = []string{}
+	}
 	t.OperationsJs = opsContainer
 
 }
 
-func (h *HiveRpcNode) broadcast(ops []hiveOperation, wif *string) (string, error) {
-	signingData, err := h.getSigningData()
+// MarshalJSON encodes the transaction in the condenser_api format accepted by broadcast_transaction.
+func (t HiveTransaction) MarshalJSON() ([]byte, error) {
+	type plainTx HiveTransaction
+	t.prepareJson()
+	return json.Marshal(plainTx(t))
+}
+
+// UnmarshalJSON decodes a transaction in the condenser_api format, such as one produced by MarshalJSON.
+func (t *HiveTransaction) UnmarshalJSON(data []byte) error {
+	type plainTx HiveTransaction
+	var raw struct {
+		plainTx
+		OperationsJs [][2]json.RawMessage `json:"operations"`
+	}
+	err := json.Unmarshal(data, &raw)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	*t = HiveTransaction(raw.plainTx)
+	t.OperationsJs = nil
+	t.Operations = nil
+	for _, opJs := range raw.OperationsJs {
+		var name string
+		err = json.Unmarshal(opJs[0], &name)
+		if err != nil {
+			return err
+		}
+
+		op, err := decodeHiveOp(name, opJs[1])
+		if err != nil {
+			return err
+		}
+		t.Operations = append(t.Operations, op)
 	}
-	tx := hiveTransaction{
-		RefBlockNum:    signingData.refBlockNum,
-		RefBlockPrefix: signingData.refBlockPrefix,
-		Expiration:     signingData.expiration,
-		Operations:     ops,
+
+	return nil
+}
+
+// TrxId returns the id the chain will assign to the transaction.
+func (t *HiveTransaction) TrxId() (string, error) {
+	return t.generateTrxId()
+}
+
+// Digest returns the chain-id-prefixed hash that the signatures of the transaction are made over.
+func (t *HiveTransaction) Digest() ([]byte, error) {
+	message, err := serializeTx(*t)
+	if err != nil {
+		return nil, err
 	}
+	return hashTxForSig(message), nil
+}
 
-	message, err := serializeTx(tx)
+// Sign signs the transaction with the given WIF and appends the signature.
+// Signing twice with the same key does not add a second signature.
+func (t *HiveTransaction) Sign(wif *string) error {
+	digest, err := t.Digest()
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	digest := hashTxForSig(message)
-	txId, _ := tx.generateTrxId()
+	return t.appendSignature(digest, wif)
+}
+
+// SignMultiple signs the transaction with each of the given WIFs, as needed
+// for accounts whose authority requires more than one key.
+func (t *HiveTransaction) SignMultiple(wifs ...*string) error {
+	digest, err := t.Digest()
+	if err != nil {
+		return err
+	}
+
+	for _, wif := range wifs {
+		err = t.appendSignature(digest, wif)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *HiveTransaction) appendSignature(digest []byte, wif *string) error {
 	sig, err := SignDigest(digest, wif)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	tx.Signatures = append(tx.Signatures, hex.EncodeToString(sig))
+	sigStr := hex.EncodeToString(sig)
+	for _, existing := range t.Signatures {
+		if existing == sigStr {
+			return nil
+		}
+	}
+
+	t.Signatures = append(t.Signatures, sigStr)
+	return nil
+}
+
+// PartiallySignedTransaction carries a transaction between signers along with
+// the digest the first signer computed for it. Each further signer recomputes
+// the digest from the transaction it received and refuses to sign on a mismatch,
+// so a transaction altered or decoded differently in transit is never signed.
+type PartiallySignedTransaction struct {
+	Transaction *HiveTransaction `json:"transaction"`
+	Digest      string           `json:"digest"`
+}
 
-	tx.prepareJson()
+func NewPartiallySignedTransaction(tx *HiveTransaction) (*PartiallySignedTransaction, error) {
+	digest, err := tx.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PartiallySignedTransaction{Transaction: tx, Digest: hex.EncodeToString(digest)}, nil
+}
+
+// VerifyDigest checks that the transaction still hashes to the carried digest.
+func (p *PartiallySignedTransaction) VerifyDigest() ([]byte, error) {
+	if p.Transaction == nil {
+		return nil, errors.New("no transaction to sign")
+	}
+
+	expected, err := hex.DecodeString(p.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := p.Transaction.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(digest, expected) {
+		return nil, errors.New("transaction digest " + hex.EncodeToString(digest) + " does not match expected digest " + p.Digest)
+	}
+
+	return digest, nil
+}
+
+// AddSignature verifies the digest and adds a signature from each of the given WIFs.
+func (p *PartiallySignedTransaction) AddSignature(wifs ...*string) error {
+	digest, err := p.VerifyDigest()
+	if err != nil {
+		return err
+	}
+
+	for _, wif := range wifs {
+		err = p.Transaction.appendSignature(digest, wif)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BroadcastTransaction broadcasts a signed transaction and returns its id.
+func (h *HiveRpcNode) BroadcastTransaction(tx *HiveTransaction) (string, error) {
+	txId, err := tx.generateTrxId()
+	if err != nil {
+		return "", err
+	}
 
 	var params []interface{}
 	params = append(params, tx)
@@ -79,3 +221,23 @@ func (h *HiveRpcNode) broadcast(ops []hiveOperation, wif *string) (string, error
 
 	return txId, nil
 }
+
+func (h *HiveRpcNode) broadcast(ops []HiveOperation, wifs ...*string) (string, error) {
+	builder := NewTransactionBuilder(ops...)
+	err := builder.FillFromNode(h)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.SignMultiple(wifs...)
+	if err != nil {
+		return "", err
+	}
+
+	return h.BroadcastTransaction(tx)
+}
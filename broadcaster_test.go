@@ -1,6 +1,9 @@
 package hivego
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestGenerateTrxIdHiveTransaction(t *testing.T) {
 	tx := getTestVoteTx()
@@ -21,3 +24,75 @@ func TestPrepareJsonHiveTransaction(t *testing.T) {
 		t.Error("Expected", expected, "got", got)
 	}
 }
+
+func TestPartiallySignedTransactionJsonRoundTrip(t *testing.T) {
+	wif1 := "5JuMt237G3m3BaT7zH4YdoycUtbw4AEPy6DLdCrKAnFGAtXyQ1W"
+	wif2 := "5JUvJcF6rQvFbZLtDFagreKCYWWcHpHApy7sbRHZ6PeZYNftLh6"
+
+	tx := getTestTx(getTwoTestOps())
+	tx.Sign(&wif1)
+	psTx, err := NewPartiallySignedTransaction(&tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	js, err := json.Marshal(psTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var received PartiallySignedTransaction
+	err = json.Unmarshal(js, &received)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = received.AddSignature(&wif2, &wif1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := len(received.Transaction.Signatures)
+	expected := 2
+	if got != expected {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestPartiallySignedTransactionDigestMismatch(t *testing.T) {
+	wif := "5JuMt237G3m3BaT7zH4YdoycUtbw4AEPy6DLdCrKAnFGAtXyQ1W"
+
+	tx := getTestVoteTx()
+	psTx, _ := NewPartiallySignedTransaction(&tx)
+	psTx.Transaction.Operations = getTwoTestOps()
+
+	if err := psTx.AddSignature(&wif); err == nil {
+		t.Error("Expected a digest mismatch error")
+	}
+	if len(psTx.Transaction.Signatures) != 0 {
+		t.Error("Expected no signatures, got", psTx.Transaction.Signatures)
+	}
+}
+
+func TestCommentOptionsJsonRoundTrip(t *testing.T) {
+	tx := getTestTx([]HiveOperation{
+		CommentOperation{Author: "a", Permlink: "p", ParentPermlink: "hive", Title: "title", Body: "body", JsonMetadata: "{}"},
+		CommentOptionsOperation{Author: "a", Permlink: "p", MaxAcceptedPayout: "1000000.000 HBD", PercentHbd: 10000, AllowVotes: true, Beneficiaries: []Beneficiary{{"c", 200}, {"b", 100}}},
+	})
+
+	js, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded HiveTransaction
+	if err = json.Unmarshal(js, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	expected, _ := tx.generateTrxId()
+	got, _ := decoded.generateTrxId()
+	if got != expected {
+		t.Error("Expected", expected, "got", got)
+	}
+}
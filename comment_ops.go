@@ -0,0 +1,250 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+const (
+	hive100Percent          = 10000
+	maxCommentBeneficiaries = 128
+)
+
+type CommentOperation struct {
+	ParentAuthor   string `json:"parent_author"`
+	ParentPermlink string `json:"parent_permlink"`
+	Author         string `json:"author"`
+	Permlink       string `json:"permlink"`
+	Title          string `json:"title"`
+	Body           string `json:"body"`
+	JsonMetadata   string `json:"json_metadata"`
+}
+
+func (o CommentOperation) opName() string {
+	return "comment"
+}
+
+func (o CommentOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Posting: []string{o.Author}}
+}
+
+type Beneficiary struct {
+	Account string `json:"account"`
+	Weight  uint16 `json:"weight"`
+}
+
+// CommentOptionsOperation sets the payout options of a comment. Beneficiaries
+// are carried in the comment_payout_beneficiaries extension and are sorted by
+// account when the operation is serialized.
+type CommentOptionsOperation struct {
+	Author               string        `json:"author"`
+	Permlink             string        `json:"permlink"`
+	MaxAcceptedPayout    string        `json:"max_accepted_payout"`
+	PercentHbd           uint16        `json:"percent_hbd"`
+	AllowVotes           bool          `json:"allow_votes"`
+	AllowCurationRewards bool          `json:"allow_curation_rewards"`
+	Beneficiaries        []Beneficiary `json:"-"`
+}
+
+func (o CommentOptionsOperation) opName() string {
+	return "comment_options"
+}
+
+func (o CommentOptionsOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Posting: []string{o.Author}}
+}
+
+// sortedBeneficiaries validates the beneficiaries the way the chain does and
+// returns them sorted by account name.
+func (o CommentOptionsOperation) sortedBeneficiaries() ([]Beneficiary, error) {
+	if o.Beneficiaries == nil {
+		return nil, nil
+	}
+	if len(o.Beneficiaries) == 0 {
+		return nil, errors.New("must specify at least one beneficiary")
+	}
+	if len(o.Beneficiaries) > maxCommentBeneficiaries {
+		return nil, errors.New("cannot specify more than 128 beneficiaries")
+	}
+
+	sorted := make([]Beneficiary, len(o.Beneficiaries))
+	copy(sorted, o.Beneficiaries)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Account < sorted[j].Account })
+
+	sum := 0
+	for i, b := range sorted {
+		if i > 0 && sorted[i-1].Account == b.Account {
+			return nil, errors.New("duplicate beneficiary: " + b.Account)
+		}
+		sum += int(b.Weight)
+	}
+	if sum > hive100Percent {
+		return nil, errors.New("cannot allocate more than 100% of rewards to beneficiaries")
+	}
+
+	return sorted, nil
+}
+
+type commentPayoutBeneficiaries struct {
+	Beneficiaries []Beneficiary `json:"beneficiaries"`
+}
+
+func (o CommentOptionsOperation) MarshalJSON() ([]byte, error) {
+	type plainOp CommentOptionsOperation
+	beneficiaries, err := o.sortedBeneficiaries()
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := []interface{}{}
+	if beneficiaries != nil {
+		extensions = append(extensions, []interface{}{0, commentPayoutBeneficiaries{beneficiaries}})
+	}
+
+	return json.Marshal(struct {
+		plainOp
+		Extensions []interface{} `json:"extensions"`
+	}{plainOp(o), extensions})
+}
+
+// UnmarshalJSON accepts the beneficiaries extension in both the condenser
+// [0, {...}] and the appbase {"type": ..., "value": {...}} form.
+func (o *CommentOptionsOperation) UnmarshalJSON(data []byte) error {
+	type plainOp CommentOptionsOperation
+	var raw struct {
+		plainOp
+		Extensions []json.RawMessage `json:"extensions"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*o = CommentOptionsOperation(raw.plainOp)
+	for _, ext := range raw.Extensions {
+		value, err := extensionValue(ext)
+		if err != nil {
+			return err
+		}
+
+		var b commentPayoutBeneficiaries
+		err = json.Unmarshal(value, &b)
+		if err != nil {
+			return err
+		}
+		o.Beneficiaries = append(o.Beneficiaries, b.Beneficiaries...)
+	}
+
+	return nil
+}
+
+func (h *HiveRpcNode) Comment(parentAuthor string, parentPermlink string, author string, permlink string, title string, body string, jsonMetadata string, wif *string) (string, error) {
+	comment := CommentOperation{parentAuthor, parentPermlink, author, permlink, title, body, jsonMetadata}
+
+	return h.broadcast([]HiveOperation{comment}, wif)
+}
+
+// CommentWithOptions broadcasts a comment together with its payout options in one transaction.
+func (h *HiveRpcNode) CommentWithOptions(comment CommentOperation, options CommentOptionsOperation, wif *string) (string, error) {
+	options.Author = comment.Author
+	options.Permlink = comment.Permlink
+
+	return h.broadcast([]HiveOperation{comment, options}, wif)
+}
+
+type DeleteCommentOperation struct {
+	Author   string `json:"author"`
+	Permlink string `json:"permlink"`
+}
+
+func (o DeleteCommentOperation) opName() string {
+	return "delete_comment"
+}
+
+func (o DeleteCommentOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Posting: []string{o.Author}}
+}
+
+// checkDeletable returns an error explaining why the chain would reject
+// deleting the content, or nil if it can be deleted.
+func checkDeletable(content types.Content) error {
+	if content.Children > 0 {
+		return errors.New("cannot delete @" + content.Author + "/" + content.Permlink + ": it has " + strconv.Itoa(content.Children) + " replies")
+	}
+	if content.NetRshares > 0 {
+		return errors.New("cannot delete @" + content.Author + "/" + content.Permlink + ": it has net positive votes")
+	}
+	if paidOut(content) {
+		return errors.New("cannot delete @" + content.Author + "/" + content.Permlink + ": it has been paid out")
+	}
+	return nil
+}
+
+// paidOut reports whether the content's payout happened. The chain then drops
+// its cashout, which the api shows as a cashout time at the 1970 epoch, and
+// sets the last payout time.
+func paidOut(content types.Content) bool {
+	epoch := time.Unix(0, 0).UTC().Add(24 * time.Hour)
+	if cashout, err := time.Parse(hiveTimeLayout, content.CashoutTime); err == nil && cashout.Before(epoch) {
+		return true
+	}
+	if lastPayout, err := time.Parse(hiveTimeLayout, content.LastPayout); err == nil && lastPayout.After(epoch) {
+		return true
+	}
+	return false
+}
+
+// DeleteComment deletes a post or comment after checking that it has no
+// replies, no net positive votes and has not been paid out, which the chain requires.
+func (h *HiveRpcNode) DeleteComment(author string, permlink string, wif *string) (string, error) {
+	content, err := h.GetContent(author, permlink)
+	if err != nil {
+		return "", err
+	}
+
+	err = checkDeletable(content)
+	if err != nil {
+		return "", err
+	}
+
+	return h.broadcast([]HiveOperation{DeleteCommentOperation{author, permlink}}, wif)
+}
+
+// createPatch returns the diff-match-patch patch from original to body,
+// or an empty string if the full body is smaller, as condenser does.
+func createPatch(original string, body string) string {
+	if original == "" {
+		return ""
+	}
+
+	dmp := diffmatchpatch.New()
+	patch := dmp.PatchToText(dmp.PatchMake(original, body))
+	if patch == "" || len(patch) >= len(body) {
+		return ""
+	}
+	return patch
+}
+
+// EditPost updates the title, body and json metadata of an existing post or
+// comment. The body is sent as a patch against the current body when that is
+// smaller than the new body.
+func (h *HiveRpcNode) EditPost(author string, permlink string, title string, body string, jsonMetadata string, wif *string) (string, error) {
+	content, err := h.GetContent(author, permlink)
+	if err != nil {
+		return "", err
+	}
+
+	if patch := createPatch(content.Body, body); patch != "" {
+		body = patch
+	}
+
+	comment := CommentOperation{content.ParentAuthor, content.ParentPermlink, author, permlink, title, body, jsonMetadata}
+
+	return h.broadcast([]HiveOperation{comment}, wif)
+}
@@ -0,0 +1,56 @@
+package hivego
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+func TestCreatePatch(t *testing.T) {
+	original := strings.Repeat("Lorem ipsum dolor sit amet. ", 20)
+	body := strings.Replace(original, "dolor", "color", 1)
+
+	patch := createPatch(original, body)
+	if patch == "" {
+		t.Fatal("Expected a patch for a small edit")
+	}
+
+	dmp := diffmatchpatch.New()
+	patches, err := dmp.PatchFromText(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := dmp.PatchApply(patches, original)
+	if got != body {
+		t.Error("Expected", body, "got", got)
+	}
+}
+
+func TestCreatePatchPrefersFullBody(t *testing.T) {
+	if got := createPatch("short", "entirely different"); got != "" {
+		t.Error("Expected no patch, got", got)
+	}
+	if got := createPatch("", "new body"); got != "" {
+		t.Error("Expected no patch, got", got)
+	}
+}
+
+func TestCheckDeletable(t *testing.T) {
+	if err := checkDeletable(types.Content{Author: "a", Permlink: "p"}); err != nil {
+		t.Error("Expected no error, got", err)
+	}
+	if err := checkDeletable(types.Content{Author: "a", Permlink: "p", Children: 2}); err == nil || !strings.Contains(err.Error(), "replies") {
+		t.Error("Expected a replies error, got", err)
+	}
+	if err := checkDeletable(types.Content{Author: "a", Permlink: "p", NetRshares: 1000}); err == nil || !strings.Contains(err.Error(), "votes") {
+		t.Error("Expected a votes error, got", err)
+	}
+	if err := checkDeletable(types.Content{Author: "a", Permlink: "p", CashoutTime: "2030-01-01T00:00:00", LastPayout: "1970-01-01T00:00:00"}); err != nil {
+		t.Error("Expected no error before payout, got", err)
+	}
+	if err := checkDeletable(types.Content{Author: "a", Permlink: "p", CashoutTime: "1969-12-31T23:59:59", LastPayout: "2023-10-30T10:00:00"}); err == nil || !strings.Contains(err.Error(), "paid out") {
+		t.Error("Expected a payout error, got", err)
+	}
+}
@@ -0,0 +1,31 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// GetContent returns the post or comment identified by author and permlink.
+func (h *HiveRpcNode) GetContent(author string, permlink string) (types.Content, error) {
+	var query = hrpcQuery{
+		method: "condenser_api.get_content",
+		params: []string{author, permlink},
+	}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.Content{}, err
+	}
+
+	var content types.Content
+	err = json.Unmarshal(res, &content)
+	if err != nil {
+		return types.Content{}, err
+	}
+
+	if content.Author == "" {
+		return types.Content{}, errors.New("content not found: @" + author + "/" + permlink)
+	}
+	return content, nil
+}
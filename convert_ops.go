@@ -0,0 +1,169 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+const (
+	conversionCollateralRatio   = 2 * hive100Percent
+	collateralizedConversionFee = 5 * hive100Percent / 100
+)
+
+// ConvertOperation converts HBD to HIVE at the median feed price after three and a half days.
+type ConvertOperation struct {
+	Owner     string `json:"owner"`
+	RequestId uint32 `json:"requestid"`
+	Amount    string `json:"amount"`
+}
+
+func (o ConvertOperation) opName() string {
+	return "convert"
+}
+
+func (o ConvertOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Owner}}
+}
+
+func (h *HiveRpcNode) Convert(owner string, requestId uint32, amount string, wif *string) (string, error) {
+	op := ConvertOperation{owner, requestId, amount}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// CollateralizedConvertOperation converts HIVE to HBD. Half of the HIVE
+// collateral is converted immediately, the rest is returned after three and
+// a half days minus what is needed to cover the final price.
+type CollateralizedConvertOperation struct {
+	Owner     string `json:"owner"`
+	RequestId uint32 `json:"requestid"`
+	Amount    string `json:"amount"`
+}
+
+func (o CollateralizedConvertOperation) opName() string {
+	return "collateralized_convert"
+}
+
+func (o CollateralizedConvertOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Owner}}
+}
+
+func (h *HiveRpcNode) CollateralizedConvert(owner string, requestId uint32, amount string, wif *string) (string, error) {
+	op := CollateralizedConvertOperation{owner, requestId, amount}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// GetConversionRequests lists the pending HBD to HIVE conversions of account.
+func (h *HiveRpcNode) GetConversionRequests(account string) ([]types.ConversionRequest, error) {
+	var query = hrpcQuery{method: "condenser_api.get_conversion_requests", params: []string{account}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []types.ConversionRequest
+	err = json.Unmarshal(res, &requests)
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// GetCollateralizedConversionRequests lists the pending HIVE to HBD conversions of account.
+func (h *HiveRpcNode) GetCollateralizedConversionRequests(account string) ([]types.CollateralizedConversionRequest, error) {
+	var query = hrpcQuery{method: "condenser_api.get_collateralized_conversion_requests", params: []string{account}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []types.CollateralizedConversionRequest
+	err = json.Unmarshal(res, &requests)
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// GetCurrentMedianHistoryPrice returns the median of the witness price feeds.
+func (h *HiveRpcNode) GetCurrentMedianHistoryPrice() (types.Price, error) {
+	var query = hrpcQuery{method: "condenser_api.get_current_median_history_price", params: []string{}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.Price{}, err
+	}
+
+	var price types.Price
+	err = json.Unmarshal(res, &price)
+	if err != nil {
+		return types.Price{}, err
+	}
+	return price, nil
+}
+
+// GetFeedHistory returns the price feed history, with the median, minimum and
+// maximum prices the chain converts with.
+func (h *HiveRpcNode) GetFeedHistory() (types.FeedHistory, error) {
+	var query = hrpcQuery{method: "condenser_api.get_feed_history", params: []string{}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.FeedHistory{}, err
+	}
+
+	var history types.FeedHistory
+	err = json.Unmarshal(res, &history)
+	if err != nil {
+		return types.FeedHistory{}, err
+	}
+	return history, nil
+}
+
+// EstimateCollateralizedConvert estimates the HBD received immediately for a
+// collateralized conversion of the given HIVE amount, at the current minimum
+// history price the chain uses.
+func (h *HiveRpcNode) EstimateCollateralizedConvert(collateral string) (string, error) {
+	history, err := h.GetFeedHistory()
+	if err != nil {
+		return "", err
+	}
+
+	return estimateCollateralizedConvert(collateral, history.CurrentMinHistory)
+}
+
+// estimateCollateralizedConvert estimates the conversion the way the chain
+// does it at minPrice: the collateral is cut by the collateral ratio and the
+// rest is converted with the conversion fee applied to the HIVE side.
+func estimateCollateralizedConvert(collateral string, minPrice types.Price) (string, error) {
+	err := checkAssetSymbol(collateral, "HIVE")
+	if err != nil {
+		return "", err
+	}
+	amount, _, _, err := parseAsset(collateral)
+	if err != nil {
+		return "", err
+	}
+
+	hbd, hive := minPrice.Base, minPrice.Quote
+	if hbd.Symbol == "HIVE" {
+		hbd, hive = hive, hbd
+	}
+	if hbd.Symbol != "HBD" || hive.Symbol != "HIVE" || hive.Amount == 0 {
+		return "", errors.New("invalid minimum price: " + minPrice.Base.String() + " / " + minPrice.Quote.String())
+	}
+
+	converted := new(big.Int).Mul(big.NewInt(amount), big.NewInt(hive100Percent))
+	converted.Quo(converted, big.NewInt(conversionCollateralRatio))
+	converted.Mul(converted, big.NewInt(hbd.Amount))
+	converted.Mul(converted, big.NewInt(hive100Percent))
+	divisor := new(big.Int).Mul(big.NewInt(hive.Amount), big.NewInt(hive100Percent+collateralizedConversionFee))
+	converted.Quo(converted, divisor)
+	if !converted.IsInt64() {
+		return "", errors.New("converted amount overflows: " + collateral)
+	}
+
+	return formatAsset(converted.Int64(), 3, "HBD"), nil
+}
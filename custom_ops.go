@@ -0,0 +1,43 @@
+package hivego
+
+// CustomOperation carries arbitrary binary data, hex encoded in Data.
+type CustomOperation struct {
+	RequiredAuths []string `json:"required_auths"`
+	Id            uint16   `json:"id"`
+	Data          string   `json:"data"`
+}
+
+func (o CustomOperation) opName() string {
+	return "custom"
+}
+
+func (o CustomOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: o.RequiredAuths}
+}
+
+// CustomBinaryOperation carries arbitrary binary data, hex encoded in Data.
+// It is disabled on chain.
+type CustomBinaryOperation struct {
+	RequiredOwnerAuths   []string    `json:"required_owner_auths"`
+	RequiredActiveAuths  []string    `json:"required_active_auths"`
+	RequiredPostingAuths []string    `json:"required_posting_auths"`
+	RequiredAuths        []Authority `json:"required_auths"`
+	Id                   string      `json:"id"`
+	Data                 string      `json:"data"`
+}
+
+func (o CustomBinaryOperation) opName() string {
+	return "custom_binary"
+}
+
+func (o CustomBinaryOperation) requiredAuths() RequiredAuthorities {
+	required := RequiredAuthorities{
+		Owner:   o.RequiredOwnerAuths,
+		Active:  o.RequiredActiveAuths,
+		Posting: o.RequiredPostingAuths,
+	}
+	for _, auth := range o.RequiredAuths {
+		required.Other = append(required.Other, auth.toAccountAuthority())
+	}
+	return required
+}
@@ -0,0 +1,1883 @@
+package hivego
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// hiveReader reads the binary encoding written by the serializer.
+type hiveReader struct {
+	data []byte
+	pos  int
+}
+
+func newHiveReader(data []byte) *hiveReader {
+	return &hiveReader{data: data}
+}
+
+func (r *hiveReader) remaining() int {
+	return len(r.data) - r.pos
+}
+
+func (r *hiveReader) readBytes(n int) ([]byte, error) {
+	if n < 0 || r.remaining() < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *hiveReader) readByte() (byte, error) {
+	b, err := r.readBytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (r *hiveReader) readBool() (bool, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return false, err
+	}
+	if b > 1 {
+		return false, errors.New("invalid bool value")
+	}
+	return b == 1, nil
+}
+
+func (r *hiveReader) readUint16() (uint16, error) {
+	b, err := r.readBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (r *hiveReader) readUint32() (uint32, error) {
+	b, err := r.readBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *hiveReader) readUint64() (uint64, error) {
+	b, err := r.readBytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (r *hiveReader) readVarint() (uint64, error) {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *hiveReader) readVString() (string, error) {
+	l, err := r.readVarint()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.readBytes(int(l))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// readVAsset reads an asset, mapping the legacy STEEM and SBD symbols back to HIVE and HBD.
+func (r *hiveReader) readVAsset() (string, error) {
+	amount, err := r.readUint64()
+	if err != nil {
+		return "", err
+	}
+	precision, err := r.readByte()
+	if err != nil {
+		return "", err
+	}
+	symbolB, err := r.readBytes(7)
+	if err != nil {
+		return "", err
+	}
+
+	symbol := strings.TrimRight(string(symbolB), "\x00")
+	switch symbol {
+	case "STEEM":
+		symbol = "HIVE"
+	case "SBD":
+		symbol = "HBD"
+	}
+
+	return formatAsset(int64(amount), int(precision), symbol), nil
+}
+
+func (r *hiveReader) readPrice() (Price, error) {
+	base, err := r.readVAsset()
+	if err != nil {
+		return Price{}, err
+	}
+	quote, err := r.readVAsset()
+	if err != nil {
+		return Price{}, err
+	}
+	return Price{base, quote}, nil
+}
+
+func (r *hiveReader) readPublicKey() (string, error) {
+	b, err := r.readBytes(33)
+	if err != nil {
+		return "", err
+	}
+	return publicKeyStringFromBytes(b), nil
+}
+
+func (r *hiveReader) readInt64() (int64, error) {
+	v, err := r.readUint64()
+	return int64(v), err
+}
+
+func (r *hiveReader) readTimePoint() (string, error) {
+	seconds, err := r.readUint32()
+	if err != nil {
+		return "", err
+	}
+	return time.Unix(int64(seconds), 0).UTC().Format(hiveTimeLayout), nil
+}
+
+// readFixedHex reads a hash or signature of exactly size bytes as hex.
+func (r *hiveReader) readFixedHex(size int) (string, error) {
+	b, err := r.readBytes(size)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// readHexBytes reads a length prefixed byte vector as hex.
+func (r *hiveReader) readHexBytes() (string, error) {
+	l, err := r.readVarint()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.readBytes(int(l))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (r *hiveReader) readStringSet() ([]string, error) {
+	count, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(r.remaining()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	strs := make([]string, 0, count)
+	for i := uint64(0); i < count; i++ {
+		s, err := r.readVString()
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, s)
+	}
+	return strs, nil
+}
+
+func (r *hiveReader) readInt64Set() ([]int64, error) {
+	count, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(r.remaining()/8) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	ids := make([]int64, 0, count)
+	for i := uint64(0); i < count; i++ {
+		id, err := r.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r *hiveReader) readAuthority() (Authority, error) {
+	threshold, err := r.readUint32()
+	if err != nil {
+		return Authority{}, err
+	}
+	auth := Authority{WeightThreshold: threshold, AccountAuths: map[string]uint16{}, KeyAuths: map[string]uint16{}}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return Authority{}, err
+	}
+	for i := uint64(0); i < count; i++ {
+		account, err := r.readVString()
+		if err != nil {
+			return Authority{}, err
+		}
+		auth.AccountAuths[account], err = r.readUint16()
+		if err != nil {
+			return Authority{}, err
+		}
+	}
+
+	count, err = r.readVarint()
+	if err != nil {
+		return Authority{}, err
+	}
+	for i := uint64(0); i < count; i++ {
+		key, err := r.readPublicKey()
+		if err != nil {
+			return Authority{}, err
+		}
+		auth.KeyAuths[key], err = r.readUint16()
+		if err != nil {
+			return Authority{}, err
+		}
+	}
+
+	return auth, nil
+}
+
+func (r *hiveReader) readOptionalAuthority() (*Authority, error) {
+	present, err := r.readBool()
+	if err != nil || !present {
+		return nil, err
+	}
+	auth, err := r.readAuthority()
+	if err != nil {
+		return nil, err
+	}
+	return &auth, nil
+}
+
+func (r *hiveReader) readOptionalPublicKey() (*string, error) {
+	present, err := r.readBool()
+	if err != nil || !present {
+		return nil, err
+	}
+	key, err := r.readPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *hiveReader) readChainProperties() (ChainProperties, error) {
+	var props ChainProperties
+	var err error
+	props.AccountCreationFee, err = r.readVAsset()
+	if err != nil {
+		return ChainProperties{}, err
+	}
+	props.MaximumBlockSize, err = r.readUint32()
+	if err != nil {
+		return ChainProperties{}, err
+	}
+	props.HbdInterestRate, err = r.readUint16()
+	if err != nil {
+		return ChainProperties{}, err
+	}
+	return props, nil
+}
+
+// readNoExtensions reads the extensions of an operation that defines none.
+// Extensions carry no length, so unknown ones cannot be skipped.
+func (r *hiveReader) readNoExtensions() error {
+	count, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+	if count != 0 {
+		return errors.New("unsupported extensions")
+	}
+	return nil
+}
+
+type opReader func(r *hiveReader) (HiveOperation, error)
+
+func readOp[T HiveOperation, PT interface {
+	*T
+	deserializeOp(r *hiveReader) error
+}](r *hiveReader) (HiveOperation, error) {
+	var op T
+	err := PT(&op).deserializeOp(r)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+var hiveOpReaders = map[string]opReader{
+	"vote":                           readOp[VoteOperation],
+	"custom_json":                    readOp[CustomJsonOperation],
+	"claim_reward_balance":           readOp[ClaimRewardOperation],
+	"transfer":                       readOp[TransferOperation],
+	"comment":                        readOp[CommentOperation],
+	"comment_options":                readOp[CommentOptionsOperation],
+	"delete_comment":                 readOp[DeleteCommentOperation],
+	"transfer_to_vesting":            readOp[TransferToVestingOperation],
+	"withdraw_vesting":               readOp[WithdrawVestingOperation],
+	"set_withdraw_vesting_route":     readOp[SetWithdrawVestingRouteOperation],
+	"delegate_vesting_shares":        readOp[DelegateVestingSharesOperation],
+	"transfer_to_savings":            readOp[TransferToSavingsOperation],
+	"transfer_from_savings":          readOp[TransferFromSavingsOperation],
+	"cancel_transfer_from_savings":   readOp[CancelTransferFromSavingsOperation],
+	"recurrent_transfer":             readOp[RecurrentTransferOperation],
+	"limit_order_create":             readOp[LimitOrderCreateOperation],
+	"limit_order_create2":            readOp[LimitOrderCreate2Operation],
+	"limit_order_cancel":             readOp[LimitOrderCancelOperation],
+	"convert":                        readOp[ConvertOperation],
+	"collateralized_convert":         readOp[CollateralizedConvertOperation],
+	"escrow_transfer":                readOp[EscrowTransferOperation],
+	"escrow_approve":                 readOp[EscrowApproveOperation],
+	"escrow_dispute":                 readOp[EscrowDisputeOperation],
+	"escrow_release":                 readOp[EscrowReleaseOperation],
+	"account_witness_vote":           readOp[AccountWitnessVoteOperation],
+	"account_witness_proxy":          readOp[AccountWitnessProxyOperation],
+	"witness_update":                 readOp[WitnessUpdateOperation],
+	"witness_set_properties":         readOp[WitnessSetPropertiesOperation],
+	"feed_publish":                   readOp[FeedPublishOperation],
+	"create_proposal":                readOp[CreateProposalOperation],
+	"update_proposal_votes":          readOp[UpdateProposalVotesOperation],
+	"remove_proposal":                readOp[RemoveProposalOperation],
+	"update_proposal":                readOp[UpdateProposalOperation],
+	"claim_account":                  readOp[ClaimAccountOperation],
+	"create_claimed_account":         readOp[CreateClaimedAccountOperation],
+	"account_create":                 readOp[AccountCreateOperation],
+	"account_create_with_delegation": readOp[AccountCreateWithDelegationOperation],
+	"account_update":                 readOp[AccountUpdateOperation],
+	"account_update2":                readOp[AccountUpdate2Operation],
+	"request_account_recovery":       readOp[RequestAccountRecoveryOperation],
+	"recover_account":                readOp[RecoverAccountOperation],
+	"change_recovery_account":        readOp[ChangeRecoveryAccountOperation],
+	"reset_account":                  readOp[ResetAccountOperation],
+	"set_reset_account":              readOp[SetResetAccountOperation],
+	"decline_voting_rights":          readOp[DeclineVotingRightsOperation],
+	"custom":                         readOp[CustomOperation],
+	"custom_binary":                  readOp[CustomBinaryOperation],
+	"pow":                            readOp[PowOperation],
+	"pow2":                           readOp[Pow2Operation],
+	"report_over_production":         readOp[ReportOverProductionOperation],
+}
+
+func (r *hiveReader) readOperation() (HiveOperation, error) {
+	id, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	for opType, opId := range getHiveOpIds() {
+		if opId != id {
+			continue
+		}
+		name := strings.TrimSuffix(opType, "_operation")
+		reader, ok := hiveOpReaders[name]
+		if !ok {
+			return nil, errors.New("unsupported operation: " + name)
+		}
+		return reader(r)
+	}
+	return nil, fmt.Errorf("unknown operation id: %d", id)
+}
+
+// DeserializeOperation decodes a single operation from its binary form, the
+// inverse of the serialization used to sign transactions.
+func DeserializeOperation(data []byte) (HiveOperation, error) {
+	r := newHiveReader(data)
+	op, err := r.readOperation()
+	if err != nil {
+		return nil, err
+	}
+	if r.remaining() != 0 {
+		return nil, errors.New("trailing bytes after operation")
+	}
+	return op, nil
+}
+
+// DeserializeTransaction decodes a transaction from its binary form. The
+// signatures, when the data carries them after the extensions, are decoded
+// too, so both signing digests and full signed transactions can be read.
+func DeserializeTransaction(data []byte) (*HiveTransaction, error) {
+	r := newHiveReader(data)
+	tx := &HiveTransaction{}
+	var err error
+	tx.RefBlockNum, err = r.readUint16()
+	if err != nil {
+		return nil, err
+	}
+	tx.RefBlockPrefix, err = r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	tx.Expiration, err = r.readTimePoint()
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(r.remaining()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	for i := uint64(0); i < count; i++ {
+		op, err := r.readOperation()
+		if err != nil {
+			return nil, err
+		}
+		tx.Operations = append(tx.Operations, op)
+	}
+
+	err = r.readNoExtensions()
+	if err != nil {
+		return nil, err
+	}
+	if r.remaining() == 0 {
+		return tx, nil
+	}
+
+	count, err = r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		sig, err := r.readFixedHex(65)
+		if err != nil {
+			return nil, err
+		}
+		tx.Signatures = append(tx.Signatures, sig)
+	}
+	if r.remaining() != 0 {
+		return nil, errors.New("trailing bytes after transaction")
+	}
+
+	return tx, nil
+}
+
+func (o *VoteOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Voter, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Author, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Permlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	weight, err := r.readUint16()
+	if err != nil {
+		return err
+	}
+	o.Weight = int16(weight)
+
+	return nil
+}
+
+func (o *CommentOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.ParentAuthor, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.ParentPermlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Author, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Permlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Title, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Body, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.JsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *TransferOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Memo, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *TransferToVestingOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *WithdrawVestingOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.VestingShares, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *LimitOrderCreateOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.OrderId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.AmountToSell, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.MinToReceive, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.FillOrKill, err = r.readBool()
+	if err != nil {
+		return err
+	}
+	o.Expiration, err = r.readTimePoint()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *LimitOrderCancelOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.OrderId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *FeedPublishOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Publisher, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.ExchangeRate, err = r.readPrice()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ConvertOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.RequestId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AccountCreateOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Fee, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Creator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewAccountName, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Owner, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.Active, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.Posting, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.MemoKey, err = r.readPublicKey()
+	if err != nil {
+		return err
+	}
+	o.JsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AccountUpdateOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Owner, err = r.readOptionalAuthority()
+	if err != nil {
+		return err
+	}
+	o.Active, err = r.readOptionalAuthority()
+	if err != nil {
+		return err
+	}
+	o.Posting, err = r.readOptionalAuthority()
+	if err != nil {
+		return err
+	}
+	o.MemoKey, err = r.readPublicKey()
+	if err != nil {
+		return err
+	}
+	o.JsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *WitnessUpdateOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Url, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.BlockSigningKey, err = r.readPublicKey()
+	if err != nil {
+		return err
+	}
+	o.Props, err = r.readChainProperties()
+	if err != nil {
+		return err
+	}
+	o.Fee, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AccountWitnessVoteOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Witness, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Approve, err = r.readBool()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AccountWitnessProxyOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Proxy, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CustomOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.RequiredAuths, err = r.readStringSet()
+	if err != nil {
+		return err
+	}
+	o.Id, err = r.readUint16()
+	if err != nil {
+		return err
+	}
+	o.Data, err = r.readHexBytes()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *DeleteCommentOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Author, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Permlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CustomJsonOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.RequiredAuths, err = r.readStringSet()
+	if err != nil {
+		return err
+	}
+	o.RequiredPostingAuths, err = r.readStringSet()
+	if err != nil {
+		return err
+	}
+	o.Id, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Json, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *SetWithdrawVestingRouteOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.FromAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.ToAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Percent, err = r.readUint16()
+	if err != nil {
+		return err
+	}
+	o.AutoVest, err = r.readBool()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *LimitOrderCreate2Operation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.OrderId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.AmountToSell, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.FillOrKill, err = r.readBool()
+	if err != nil {
+		return err
+	}
+	o.ExchangeRate, err = r.readPrice()
+	if err != nil {
+		return err
+	}
+	o.Expiration, err = r.readTimePoint()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ClaimAccountOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Creator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Fee, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *CreateClaimedAccountOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Creator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewAccountName, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Owner, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.Active, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.Posting, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.MemoKey, err = r.readPublicKey()
+	if err != nil {
+		return err
+	}
+	o.JsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *RequestAccountRecoveryOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.RecoveryAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.AccountToRecover, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewOwnerAuthority, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *RecoverAccountOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.AccountToRecover, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewOwnerAuthority, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.RecentOwnerAuthority, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *ChangeRecoveryAccountOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.AccountToRecover, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewRecoveryAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *EscrowTransferOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.HbdAmount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.HiveAmount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.EscrowId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.Agent, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Fee, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.JsonMeta, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.RatificationDeadline, err = r.readTimePoint()
+	if err != nil {
+		return err
+	}
+	o.EscrowExpiration, err = r.readTimePoint()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *EscrowDisputeOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Agent, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Who, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.EscrowId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *EscrowReleaseOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Agent, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Who, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Receiver, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.EscrowId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.HbdAmount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.HiveAmount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *EscrowApproveOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Agent, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Who, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.EscrowId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.Approve, err = r.readBool()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *TransferToSavingsOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Memo, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *TransferFromSavingsOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.RequestId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Memo, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CancelTransferFromSavingsOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.RequestId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CustomBinaryOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.RequiredOwnerAuths, err = r.readStringSet()
+	if err != nil {
+		return err
+	}
+	o.RequiredActiveAuths, err = r.readStringSet()
+	if err != nil {
+		return err
+	}
+	o.RequiredPostingAuths, err = r.readStringSet()
+	if err != nil {
+		return err
+	}
+	count, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < count; i++ {
+		auth, err := r.readAuthority()
+		if err != nil {
+			return err
+		}
+		o.RequiredAuths = append(o.RequiredAuths, auth)
+	}
+	o.Id, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Data, err = r.readHexBytes()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *DeclineVotingRightsOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Decline, err = r.readBool()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ResetAccountOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.ResetAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.AccountToReset, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewOwnerAuthority, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *SetResetAccountOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.CurrentResetAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.ResetAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ClaimRewardOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.RewardHIVE, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.RewardHBD, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.RewardVests, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *DelegateVestingSharesOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Delegator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Delegatee, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.VestingShares, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AccountCreateWithDelegationOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Fee, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Delegation, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Creator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.NewAccountName, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Owner, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.Active, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.Posting, err = r.readAuthority()
+	if err != nil {
+		return err
+	}
+	o.MemoKey, err = r.readPublicKey()
+	if err != nil {
+		return err
+	}
+	o.JsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *AccountUpdate2Operation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Account, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Owner, err = r.readOptionalAuthority()
+	if err != nil {
+		return err
+	}
+	o.Active, err = r.readOptionalAuthority()
+	if err != nil {
+		return err
+	}
+	o.Posting, err = r.readOptionalAuthority()
+	if err != nil {
+		return err
+	}
+	o.MemoKey, err = r.readOptionalPublicKey()
+	if err != nil {
+		return err
+	}
+	o.JsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.PostingJsonMetadata, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *CreateProposalOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Creator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Receiver, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.StartDate, err = r.readTimePoint()
+	if err != nil {
+		return err
+	}
+	o.EndDate, err = r.readTimePoint()
+	if err != nil {
+		return err
+	}
+	o.DailyPay, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Subject, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Permlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *UpdateProposalVotesOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Voter, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.ProposalIds, err = r.readInt64Set()
+	if err != nil {
+		return err
+	}
+	o.Approve, err = r.readBool()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *RemoveProposalOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.ProposalOwner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.ProposalIds, err = r.readInt64Set()
+	if err != nil {
+		return err
+	}
+
+	return r.readNoExtensions()
+}
+
+func (o *CollateralizedConvertOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.RequestId, err = r.readUint32()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CommentOptionsOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Author, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Permlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.MaxAcceptedPayout, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.PercentHbd, err = r.readUint16()
+	if err != nil {
+		return err
+	}
+	o.AllowVotes, err = r.readBool()
+	if err != nil {
+		return err
+	}
+	o.AllowCurationRewards, err = r.readBool()
+	if err != nil {
+		return err
+	}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < count; i++ {
+		tag, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		if tag != 0 {
+			return errors.New("unsupported comment_options extension")
+		}
+
+		beneficiaries, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		for j := uint64(0); j < beneficiaries; j++ {
+			var b Beneficiary
+			b.Account, err = r.readVString()
+			if err != nil {
+				return err
+			}
+			b.Weight, err = r.readUint16()
+			if err != nil {
+				return err
+			}
+			o.Beneficiaries = append(o.Beneficiaries, b)
+		}
+	}
+
+	return nil
+}
+
+func (o *RecurrentTransferOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.From, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.To, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Amount, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Memo, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Recurrence, err = r.readUint16()
+	if err != nil {
+		return err
+	}
+	o.Executions, err = r.readUint16()
+	if err != nil {
+		return err
+	}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < count; i++ {
+		tag, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		if tag != 0 {
+			return errors.New("unsupported recurrent_transfer extension")
+		}
+		pairId, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		o.PairId = &pairId
+	}
+
+	return nil
+}
+
+func (o *UpdateProposalOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.ProposalId, err = r.readInt64()
+	if err != nil {
+		return err
+	}
+	o.Creator, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.DailyPay, err = r.readVAsset()
+	if err != nil {
+		return err
+	}
+	o.Subject, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.Permlink, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < count; i++ {
+		tag, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		switch tag {
+		case 0:
+			// void_t
+		case 1:
+			endDate, err := r.readTimePoint()
+			if err != nil {
+				return err
+			}
+			o.EndDate = &endDate
+		default:
+			return errors.New("unsupported update_proposal extension")
+		}
+	}
+
+	return nil
+}
+
+func (o *WitnessSetPropertiesOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Owner, err = r.readVString()
+	if err != nil {
+		return err
+	}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < count; i++ {
+		name, err := r.readVString()
+		if err != nil {
+			return err
+		}
+		l, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		value, err := r.readBytes(int(l))
+		if err != nil {
+			return err
+		}
+		err = o.Props.deserialize(name, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.readNoExtensions()
+}
+
+func (r *hiveReader) readPow2Input() (Pow2Input, error) {
+	var input Pow2Input
+	var err error
+	input.WorkerAccount, err = r.readVString()
+	if err != nil {
+		return Pow2Input{}, err
+	}
+	input.PrevBlock, err = r.readFixedHex(20)
+	if err != nil {
+		return Pow2Input{}, err
+	}
+	input.Nonce, err = r.readUint64()
+	if err != nil {
+		return Pow2Input{}, err
+	}
+	return input, nil
+}
+
+func (o *PowOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.WorkerAccount, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.BlockId, err = r.readFixedHex(20)
+	if err != nil {
+		return err
+	}
+	o.Nonce, err = r.readUint64()
+	if err != nil {
+		return err
+	}
+	o.Work.Worker, err = r.readPublicKey()
+	if err != nil {
+		return err
+	}
+	o.Work.Input, err = r.readFixedHex(32)
+	if err != nil {
+		return err
+	}
+	o.Work.Signature, err = r.readFixedHex(65)
+	if err != nil {
+		return err
+	}
+	o.Work.Work, err = r.readFixedHex(32)
+	if err != nil {
+		return err
+	}
+	o.Props, err = r.readChainProperties()
+	return err
+}
+
+func (o *Pow2Operation) deserializeOp(r *hiveReader) error {
+	tag, err := r.readVarint()
+	if err != nil {
+		return err
+	}
+
+	switch tag {
+	case 0:
+		work := &Pow2{}
+		work.Input, err = r.readPow2Input()
+		if err != nil {
+			return err
+		}
+		work.PowSummary, err = r.readUint32()
+		if err != nil {
+			return err
+		}
+		o.Work.Pow2 = work
+	case 1:
+		work := &EquihashPow{}
+		work.Input, err = r.readPow2Input()
+		if err != nil {
+			return err
+		}
+		work.Proof.N, err = r.readUint32()
+		if err != nil {
+			return err
+		}
+		work.Proof.K, err = r.readUint32()
+		if err != nil {
+			return err
+		}
+		work.Proof.Seed, err = r.readFixedHex(32)
+		if err != nil {
+			return err
+		}
+		count, err := r.readVarint()
+		if err != nil {
+			return err
+		}
+		if count > uint64(r.remaining()/4) {
+			return io.ErrUnexpectedEOF
+		}
+		for i := uint64(0); i < count; i++ {
+			input, err := r.readUint32()
+			if err != nil {
+				return err
+			}
+			work.Proof.Inputs = append(work.Proof.Inputs, input)
+		}
+		work.PrevBlock, err = r.readFixedHex(20)
+		if err != nil {
+			return err
+		}
+		work.PowSummary, err = r.readUint32()
+		if err != nil {
+			return err
+		}
+		o.Work.EquihashPow = work
+	default:
+		return errors.New("unknown pow2 work type")
+	}
+
+	o.NewOwnerKey, err = r.readOptionalPublicKey()
+	if err != nil {
+		return err
+	}
+	o.Props, err = r.readChainProperties()
+	return err
+}
+
+// readVersion reads a packed version and formats it as "major.hardfork.revision".
+func (r *hiveReader) readVersion() (string, error) {
+	v, err := r.readUint32()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d.%d.%d", v>>24, v>>16&0xff, v&0xffff), nil
+}
+
+func (r *hiveReader) readSignedBlockHeader() (SignedBlockHeader, error) {
+	var h SignedBlockHeader
+	var err error
+	h.Previous, err = r.readFixedHex(20)
+	if err != nil {
+		return SignedBlockHeader{}, err
+	}
+	h.Timestamp, err = r.readTimePoint()
+	if err != nil {
+		return SignedBlockHeader{}, err
+	}
+	h.Witness, err = r.readVString()
+	if err != nil {
+		return SignedBlockHeader{}, err
+	}
+	h.TransactionMerkleRoot, err = r.readFixedHex(20)
+	if err != nil {
+		return SignedBlockHeader{}, err
+	}
+
+	count, err := r.readVarint()
+	if err != nil {
+		return SignedBlockHeader{}, err
+	}
+	for i := uint64(0); i < count; i++ {
+		tag, err := r.readVarint()
+		if err != nil {
+			return SignedBlockHeader{}, err
+		}
+
+		var ext BlockHeaderExtension
+		switch tag {
+		case 0:
+			// void_t
+		case 1:
+			version, err := r.readVersion()
+			if err != nil {
+				return SignedBlockHeader{}, err
+			}
+			ext.Version = &version
+		case 2:
+			vote := &HardforkVersionVote{}
+			vote.HfVersion, err = r.readVersion()
+			if err != nil {
+				return SignedBlockHeader{}, err
+			}
+			vote.HfTime, err = r.readTimePoint()
+			if err != nil {
+				return SignedBlockHeader{}, err
+			}
+			ext.HardforkVersionVote = vote
+		default:
+			return SignedBlockHeader{}, errors.New("unknown block header extension")
+		}
+		h.Extensions = append(h.Extensions, ext)
+	}
+
+	h.WitnessSignature, err = r.readFixedHex(65)
+	if err != nil {
+		return SignedBlockHeader{}, err
+	}
+	return h, nil
+}
+
+func (o *ReportOverProductionOperation) deserializeOp(r *hiveReader) error {
+	var err error
+	o.Reporter, err = r.readVString()
+	if err != nil {
+		return err
+	}
+	o.FirstBlock, err = r.readSignedBlockHeader()
+	if err != nil {
+		return err
+	}
+	o.SecondBlock, err = r.readSignedBlockHeader()
+	return err
+}
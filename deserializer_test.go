@@ -0,0 +1,106 @@
+package hivego
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDeserializeOperationRoundTrip(t *testing.T) {
+	for _, op := range getTestOps() {
+		expected, err := op.serializeOp()
+		if err != nil {
+			t.Fatal(op.opName(), err)
+		}
+
+		decoded, err := DeserializeOperation(expected)
+		if err != nil {
+			t.Fatal(op.opName(), err)
+		}
+		if decoded.opName() != op.opName() {
+			t.Fatal(op.opName(), "decoded as", decoded.opName())
+		}
+
+		got, err := decoded.serializeOp()
+		if err != nil {
+			t.Fatal(op.opName(), err)
+		}
+		if !bytes.Equal(got, expected) {
+			t.Error(op.opName(), "did not round trip:", hex.EncodeToString(got), hex.EncodeToString(expected))
+		}
+	}
+}
+
+func TestDeserializeOperationErrors(t *testing.T) {
+	expected, err := getTestVoteOp().serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range map[string][]byte{
+		"truncated": expected[:len(expected)-1],
+		"trailing":  append(append([]byte{}, expected...), 0),
+		"unknown":   {0x7f},
+		"empty":     {},
+	} {
+		_, err := DeserializeOperation(data)
+		if err == nil {
+			t.Error(name, "expected an error")
+		}
+	}
+}
+
+func TestDeserializeLegacyAssetSymbols(t *testing.T) {
+	op := TransferOperation{From: "alice", To: "bob", Amount: "1.000 HBD", Memo: ""}
+	b, err := op.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(b, []byte("SBD\x00")) {
+		t.Fatal("expected the legacy SBD symbol in", hex.EncodeToString(b))
+	}
+
+	decoded, err := DeserializeOperation(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.(TransferOperation) != op {
+		t.Error("got", decoded, "expected", op)
+	}
+}
+
+func TestDeserializeTransaction(t *testing.T) {
+	tx := getTestTx(getTestOps())
+	b, err := serializeTx(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := DeserializeTransaction(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := serializeTx(*decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, b) {
+		t.Error("transaction did not round trip")
+	}
+
+	sig := strings.Repeat("1f", 65)
+	sigB, _ := hex.DecodeString(sig)
+	signed := append(append(append([]byte{}, b...), 1), sigB...)
+	decoded, err = DeserializeTransaction(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Signatures, []string{sig}) {
+		t.Error("got signatures", decoded.Signatures)
+	}
+	if decoded.Expiration != tx.Expiration || decoded.RefBlockNum != tx.RefBlockNum || decoded.RefBlockPrefix != tx.RefBlockPrefix {
+		t.Error("got header", decoded.RefBlockNum, decoded.RefBlockPrefix, decoded.Expiration)
+	}
+}
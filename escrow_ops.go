@@ -0,0 +1,145 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type EscrowTransferOperation struct {
+	From                 string `json:"from"`
+	To                   string `json:"to"`
+	Agent                string `json:"agent"`
+	EscrowId             uint32 `json:"escrow_id"`
+	HbdAmount            string `json:"hbd_amount"`
+	HiveAmount           string `json:"hive_amount"`
+	Fee                  string `json:"fee"`
+	RatificationDeadline string `json:"ratification_deadline"`
+	EscrowExpiration     string `json:"escrow_expiration"`
+	JsonMeta             string `json:"json_meta"`
+}
+
+func (o EscrowTransferOperation) opName() string {
+	return "escrow_transfer"
+}
+
+func (o EscrowTransferOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
+}
+
+// EscrowTransfer places HBD and HIVE in escrow with agent. The agent and to
+// must approve before ratificationDeadline, and the funds can be released
+// freely by either party after escrowExpiration.
+func (h *HiveRpcNode) EscrowTransfer(from string, to string, agent string, escrowId uint32, hbdAmount string, hiveAmount string, fee string, ratificationDeadline time.Time, escrowExpiration time.Time, jsonMeta string, wif *string) (string, error) {
+	op := EscrowTransferOperation{
+		From:                 from,
+		To:                   to,
+		Agent:                agent,
+		EscrowId:             escrowId,
+		HbdAmount:            hbdAmount,
+		HiveAmount:           hiveAmount,
+		Fee:                  fee,
+		RatificationDeadline: ratificationDeadline.UTC().Format(hiveTimeLayout),
+		EscrowExpiration:     escrowExpiration.UTC().Format(hiveTimeLayout),
+		JsonMeta:             jsonMeta,
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type EscrowApproveOperation struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Agent    string `json:"agent"`
+	Who      string `json:"who"`
+	EscrowId uint32 `json:"escrow_id"`
+	Approve  bool   `json:"approve"`
+}
+
+func (o EscrowApproveOperation) opName() string {
+	return "escrow_approve"
+}
+
+func (o EscrowApproveOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Who}}
+}
+
+// EscrowApprove approves or rejects an escrow as who, which must be its to or agent.
+func (h *HiveRpcNode) EscrowApprove(from string, to string, agent string, who string, escrowId uint32, approve bool, wif *string) (string, error) {
+	op := EscrowApproveOperation{from, to, agent, who, escrowId, approve}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type EscrowDisputeOperation struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Agent    string `json:"agent"`
+	Who      string `json:"who"`
+	EscrowId uint32 `json:"escrow_id"`
+}
+
+func (o EscrowDisputeOperation) opName() string {
+	return "escrow_dispute"
+}
+
+func (o EscrowDisputeOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Who}}
+}
+
+// EscrowDispute raises a dispute as who, which must be the escrow's from or to,
+// handing the release of the funds to the agent.
+func (h *HiveRpcNode) EscrowDispute(from string, to string, agent string, who string, escrowId uint32, wif *string) (string, error) {
+	op := EscrowDisputeOperation{from, to, agent, who, escrowId}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type EscrowReleaseOperation struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Agent      string `json:"agent"`
+	Who        string `json:"who"`
+	Receiver   string `json:"receiver"`
+	EscrowId   uint32 `json:"escrow_id"`
+	HbdAmount  string `json:"hbd_amount"`
+	HiveAmount string `json:"hive_amount"`
+}
+
+func (o EscrowReleaseOperation) opName() string {
+	return "escrow_release"
+}
+
+func (o EscrowReleaseOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Who}}
+}
+
+// EscrowRelease releases escrowed funds to receiver as who. Before expiration
+// without a dispute, from releases to to and to releases to from; during a
+// dispute only the agent can release.
+func (h *HiveRpcNode) EscrowRelease(from string, to string, agent string, who string, receiver string, escrowId uint32, hbdAmount string, hiveAmount string, wif *string) (string, error) {
+	op := EscrowReleaseOperation{from, to, agent, who, receiver, escrowId, hbdAmount, hiveAmount}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+func (h *HiveRpcNode) GetEscrow(from string, escrowId uint32) (types.Escrow, error) {
+	var query = hrpcQuery{method: "condenser_api.get_escrow", params: []interface{}{from, escrowId}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.Escrow{}, err
+	}
+
+	var escrow *types.Escrow
+	err = json.Unmarshal(res, &escrow)
+	if err != nil {
+		return types.Escrow{}, err
+	}
+	if escrow == nil {
+		return types.Escrow{}, errors.New("escrow not found: " + from + " " + strconv.FormatUint(uint64(escrowId), 10))
+	}
+	return *escrow, nil
+}
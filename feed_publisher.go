@@ -0,0 +1,200 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type FeedPublishOperation struct {
+	Publisher    string `json:"publisher"`
+	ExchangeRate Price  `json:"exchange_rate"`
+}
+
+func (o FeedPublishOperation) opName() string {
+	return "feed_publish"
+}
+
+func (o FeedPublishOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Publisher}}
+}
+
+// FeedPublish publishes the witness' price feed, such as base "0.250 HBD" and quote "1.000 HIVE".
+func (h *HiveRpcNode) FeedPublish(publisher string, exchangeRate Price, wif *string) (string, error) {
+	op := FeedPublishOperation{publisher, exchangeRate}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+func (h *HiveRpcNode) GetWitnessByAccount(account string) (types.Witness, error) {
+	var query = hrpcQuery{method: "condenser_api.get_witness_by_account", params: []string{account}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.Witness{}, err
+	}
+
+	var witness *types.Witness
+	err = json.Unmarshal(res, &witness)
+	if err != nil {
+		return types.Witness{}, err
+	}
+	if witness == nil {
+		return types.Witness{}, errors.New("witness not found: " + account)
+	}
+	return *witness, nil
+}
+
+// PriceSource provides the price of one HIVE in USD, which the feed publishes as HBD.
+type PriceSource interface {
+	HivePrice() (float64, error)
+}
+
+// FeedPublisher publishes the median price of its sources as a witness feed.
+// It publishes when the feed on chain is older than MaxAge, even if the price
+// has not moved, since the chain drops feeds older than a week from its
+// median. A younger feed is only replaced when it deviates from the median by
+// more than MaxDeviation.
+type FeedPublisher struct {
+	Node          *HiveRpcNode
+	Witness       string
+	Wif           *string
+	Sources       []PriceSource
+	CheckInterval time.Duration
+	MaxAge        time.Duration
+	MaxDeviation  float64
+}
+
+// NewFeedPublisher returns a publisher that checks its sources every five
+// minutes and publishes at least every twelve hours or on a 3% deviation.
+func NewFeedPublisher(node *HiveRpcNode, witness string, wif *string, sources ...PriceSource) *FeedPublisher {
+	return &FeedPublisher{
+		Node:          node,
+		Witness:       witness,
+		Wif:           wif,
+		Sources:       sources,
+		CheckInterval: 5 * time.Minute,
+		MaxAge:        12 * time.Hour,
+		MaxDeviation:  0.03,
+	}
+}
+
+// MedianPrice queries every source and returns the median of the prices
+// they report. Sources that fail are skipped.
+func (p *FeedPublisher) MedianPrice() (float64, error) {
+	var prices []float64
+	for _, source := range p.Sources {
+		price, err := source.HivePrice()
+		if err != nil {
+			log.Printf("Price source failed: %v", err)
+			continue
+		}
+		if price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+			log.Printf("Price source returned invalid price %v", price)
+			continue
+		}
+		prices = append(prices, price)
+	}
+
+	if len(prices) == 0 {
+		return 0, errors.New("no price source returned a price")
+	}
+
+	sort.Float64s(prices)
+	mid := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return (prices[mid-1] + prices[mid]) / 2, nil
+	}
+	return prices[mid], nil
+}
+
+// feedPrice formats a HIVE price as the exchange rate of a feed.
+func feedPrice(price float64) Price {
+	return Price{
+		Base:  formatAsset(int64(math.Round(price*1000)), 3, "HBD"),
+		Quote: "1.000 HIVE",
+	}
+}
+
+// priceOf returns the HBD per HIVE rate of a price in either orientation.
+func priceOf(price types.Price) float64 {
+	hbd, hive := price.Base, price.Quote
+	if hbd.Symbol == "HIVE" {
+		hbd, hive = hive, hbd
+	}
+	if hive.Amount == 0 {
+		return 0
+	}
+	return float64(hbd.Amount) / float64(hive.Amount)
+}
+
+// shouldPublish decides whether to publish exchangeRate over the current feed.
+func (p *FeedPublisher) shouldPublish(exchangeRate Price, current types.Price, lastUpdate time.Time, now time.Time) bool {
+	rate, err := exchangeRate.Types()
+	if err != nil {
+		return false
+	}
+	newPrice := priceOf(rate)
+
+	currentPrice := priceOf(current)
+	if currentPrice == 0 || now.Sub(lastUpdate) >= p.MaxAge {
+		return true
+	}
+	if math.Abs(newPrice-currentPrice) < 0.0005 {
+		return false
+	}
+
+	return math.Abs(newPrice-currentPrice)/currentPrice > p.MaxDeviation
+}
+
+// PublishIfNeeded publishes the median price if the feed on chain is stale
+// or deviates too far from it. It returns the transaction id, or an empty
+// string if nothing was published.
+func (p *FeedPublisher) PublishIfNeeded() (string, error) {
+	price, err := p.MedianPrice()
+	if err != nil {
+		return "", err
+	}
+	exchangeRate := feedPrice(price)
+
+	witness, err := p.Node.GetWitnessByAccount(p.Witness)
+	if err != nil {
+		return "", err
+	}
+
+	lastUpdate, err := time.Parse(hiveTimeLayout, witness.LastHbdExchangeUpdate)
+	if err != nil {
+		return "", err
+	}
+
+	if !p.shouldPublish(exchangeRate, witness.HbdExchangeRate, lastUpdate, time.Now().UTC()) {
+		return "", nil
+	}
+
+	return p.Node.FeedPublish(p.Witness, exchangeRate, p.Wif)
+}
+
+// Run checks the price every CheckInterval until stop is closed.
+func (p *FeedPublisher) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(p.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		txId, err := p.PublishIfNeeded()
+		if err != nil {
+			log.Printf("Failed to publish price feed for %s: %v", p.Witness, err)
+		} else if txId != "" {
+			log.Printf("Published price feed for %s in %s", p.Witness, txId)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
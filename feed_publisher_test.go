@@ -0,0 +1,60 @@
+package hivego
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type staticPriceSource struct {
+	price float64
+	err   error
+}
+
+func (s staticPriceSource) HivePrice() (float64, error) {
+	return s.price, s.err
+}
+
+func TestMedianPrice(t *testing.T) {
+	p := NewFeedPublisher(nil, "witness", nil,
+		staticPriceSource{price: 0.30},
+		staticPriceSource{err: errors.New("offline")},
+		staticPriceSource{price: 0.25},
+		staticPriceSource{price: 0.26},
+		staticPriceSource{price: 0.90},
+	)
+
+	got, err := p.MedianPrice()
+	expected := 0.28
+	if err != nil || got < expected-1e-9 || got > expected+1e-9 {
+		t.Error("Expected", expected, "got", got, err)
+	}
+}
+
+func TestShouldPublish(t *testing.T) {
+	p := NewFeedPublisher(nil, "witness", nil)
+	current := types.Price{Base: types.Asset{Amount: 250, Precision: 3, Symbol: "HBD"}, Quote: types.Asset{Amount: 1000, Precision: 3, Symbol: "HIVE"}}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		price      float64
+		lastUpdate time.Time
+		expected   bool
+	}{
+		{0.250, now.Add(-24 * time.Hour), true},
+		{0.250, now.Add(-time.Hour), false},
+		{0.255, now.Add(-time.Hour), false},
+		{0.255, now.Add(-24 * time.Hour), true},
+		{0.260, now.Add(-time.Hour), true},
+		{0.240, now.Add(-time.Hour), true},
+	}
+
+	for _, test := range tests {
+		got := p.shouldPublish(feedPrice(test.price), current, test.lastUpdate, now)
+		if got != test.expected {
+			t.Error("Expected", test.expected, "for", test.price, "got", got)
+		}
+	}
+}
@@ -6,6 +6,7 @@ require (
 	github.com/cfoxon/jsonrpc2client v0.0.0-20220410030230-4f361e74821a
 	github.com/decred/base58 v1.0.4
 	github.com/decred/dcrd/dcrec/secp256k1/v2 v2.0.0
+	github.com/sergi/go-diff v1.3.1
 	golang.org/x/crypto v0.0.0-20220214200702-86341886e292
 )
 
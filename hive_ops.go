@@ -2,58 +2,72 @@ package hivego
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 )
 
-type hiveOperation interface {
+// HiveOperation is an operation that can be added to a HiveTransaction.
+// It is implemented by the operation types of this package.
+type HiveOperation interface {
 	serializeOp() ([]byte, error)
 	opName() string
+	requiredAuths() RequiredAuthorities
 }
 
-type voteOperation struct {
+type VoteOperation struct {
 	Voter    string `json:"voter"`
 	Author   string `json:"author"`
 	Permlink string `json:"permlink"`
 	Weight   int16  `json:"weight"`
-	opText   string
 }
 
-func (o voteOperation) opName() string {
-	return o.opText
+func (o VoteOperation) opName() string {
+	return "vote"
+}
+
+func (o VoteOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Posting: []string{o.Voter}}
 }
 
 func (h *HiveRpcNode) VotePost(voter string, author string, permlink string, weight int, wif *string) (string, error) {
-	vote := voteOperation{voter, author, permlink, int16(weight), "vote"}
+	vote := VoteOperation{voter, author, permlink, int16(weight)}
 
-	return h.broadcast([]hiveOperation{vote}, wif)
+	return h.broadcast([]HiveOperation{vote}, wif)
 }
 
-type customJsonOperation struct {
+type CustomJsonOperation struct {
 	RequiredAuths        []string `json:"required_auths"`
 	RequiredPostingAuths []string `json:"required_posting_auths"`
 	Id                   string   `json:"id"`
 	Json                 string   `json:"json"`
-	opText               string
 }
 
-func (o customJsonOperation) opName() string {
-	return o.opText
+func (o CustomJsonOperation) opName() string {
+	return "custom_json"
+}
+
+func (o CustomJsonOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: o.RequiredAuths, Posting: o.RequiredPostingAuths}
 }
 
 func (h *HiveRpcNode) BroadcastJson(reqAuth []string, reqPostAuth []string, id string, cj string, wif *string) (string, error) {
-	op := customJsonOperation{reqAuth, reqPostAuth, id, cj, "custom_json"}
-	return h.broadcast([]hiveOperation{op}, wif)
+	op := CustomJsonOperation{reqAuth, reqPostAuth, id, cj}
+	return h.broadcast([]HiveOperation{op}, wif)
 }
 
-type claimRewardOperation struct {
+type ClaimRewardOperation struct {
 	Account     string `json:"account"`
 	RewardHBD   string `json:"reward_hbd"`
 	RewardHIVE  string `json:"reward_hive"`
 	RewardVests string `json:"reward_vests"`
-	opText      string
 }
 
-func (o claimRewardOperation) opName() string {
-	return o.opText
+func (o ClaimRewardOperation) opName() string {
+	return "claim_reward_balance"
+}
+
+func (o ClaimRewardOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Posting: []string{o.Account}}
 }
 
 func (h *HiveRpcNode) ClaimRewards(Account string, wif *string) (string, error) {
@@ -64,8 +78,8 @@ func (h *HiveRpcNode) ClaimRewards(Account string, wif *string) (string, error)
 	}
 
 	for _, accounts := range accountData {
-		claim := claimRewardOperation{Account, accounts.RewardHbdBalance, accounts.RewardHiveBalance, accounts.RewardVestingBalance, "claim_reward_balance"}
-		broadcast, err := h.broadcast([]hiveOperation{claim}, wif)
+		claim := ClaimRewardOperation{Account, accounts.RewardHbdBalance, accounts.RewardHiveBalance, accounts.RewardVestingBalance}
+		broadcast, err := h.broadcast([]HiveOperation{claim}, wif)
 		return broadcast, err
 	}
 
@@ -73,22 +87,115 @@ func (h *HiveRpcNode) ClaimRewards(Account string, wif *string) (string, error)
 
 }
 
-type transferOperation struct {
+type TransferOperation struct {
 	From   string `json:"from"`
 	To     string `json:"to"`
 	Amount string `json:"amount"`
 	Memo   string `json:"memo"`
-	opText string
 }
 
-func (o transferOperation) opName() string {
-	return o.opText
+func (o TransferOperation) opName() string {
+	return "transfer"
+}
+
+func (o TransferOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
 }
 
 func (h *HiveRpcNode) Transfer(from string, to string, amount string, memo string, wif *string) (string, error) {
-	transfer := transferOperation{from, to, amount, memo, "transfer"}
+	transfer := TransferOperation{from, to, amount, memo}
 
-	return h.broadcast([]hiveOperation{transfer}, wif)
+	return h.broadcast([]HiveOperation{transfer}, wif)
+}
+
+type opDecoder func(data []byte) (HiveOperation, error)
+
+func decodeOp[T HiveOperation](data []byte) (HiveOperation, error) {
+	var op T
+	err := json.Unmarshal(data, &op)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+var hiveOpDecoders = map[string]opDecoder{
+	"vote":                           decodeOp[VoteOperation],
+	"custom_json":                    decodeOp[CustomJsonOperation],
+	"claim_reward_balance":           decodeOp[ClaimRewardOperation],
+	"transfer":                       decodeOp[TransferOperation],
+	"comment":                        decodeOp[CommentOperation],
+	"comment_options":                decodeOp[CommentOptionsOperation],
+	"delete_comment":                 decodeOp[DeleteCommentOperation],
+	"transfer_to_vesting":            decodeOp[TransferToVestingOperation],
+	"withdraw_vesting":               decodeOp[WithdrawVestingOperation],
+	"set_withdraw_vesting_route":     decodeOp[SetWithdrawVestingRouteOperation],
+	"delegate_vesting_shares":        decodeOp[DelegateVestingSharesOperation],
+	"transfer_to_savings":            decodeOp[TransferToSavingsOperation],
+	"transfer_from_savings":          decodeOp[TransferFromSavingsOperation],
+	"cancel_transfer_from_savings":   decodeOp[CancelTransferFromSavingsOperation],
+	"recurrent_transfer":             decodeOp[RecurrentTransferOperation],
+	"limit_order_create":             decodeOp[LimitOrderCreateOperation],
+	"limit_order_create2":            decodeOp[LimitOrderCreate2Operation],
+	"limit_order_cancel":             decodeOp[LimitOrderCancelOperation],
+	"convert":                        decodeOp[ConvertOperation],
+	"collateralized_convert":         decodeOp[CollateralizedConvertOperation],
+	"escrow_transfer":                decodeOp[EscrowTransferOperation],
+	"escrow_approve":                 decodeOp[EscrowApproveOperation],
+	"escrow_dispute":                 decodeOp[EscrowDisputeOperation],
+	"escrow_release":                 decodeOp[EscrowReleaseOperation],
+	"account_witness_vote":           decodeOp[AccountWitnessVoteOperation],
+	"account_witness_proxy":          decodeOp[AccountWitnessProxyOperation],
+	"witness_update":                 decodeOp[WitnessUpdateOperation],
+	"witness_set_properties":         decodeOp[WitnessSetPropertiesOperation],
+	"feed_publish":                   decodeOp[FeedPublishOperation],
+	"create_proposal":                decodeOp[CreateProposalOperation],
+	"update_proposal_votes":          decodeOp[UpdateProposalVotesOperation],
+	"remove_proposal":                decodeOp[RemoveProposalOperation],
+	"update_proposal":                decodeOp[UpdateProposalOperation],
+	"claim_account":                  decodeOp[ClaimAccountOperation],
+	"create_claimed_account":         decodeOp[CreateClaimedAccountOperation],
+	"account_create":                 decodeOp[AccountCreateOperation],
+	"account_create_with_delegation": decodeOp[AccountCreateWithDelegationOperation],
+	"account_update":                 decodeOp[AccountUpdateOperation],
+	"account_update2":                decodeOp[AccountUpdate2Operation],
+	"request_account_recovery":       decodeOp[RequestAccountRecoveryOperation],
+	"recover_account":                decodeOp[RecoverAccountOperation],
+	"change_recovery_account":        decodeOp[ChangeRecoveryAccountOperation],
+	"reset_account":                  decodeOp[ResetAccountOperation],
+	"set_reset_account":              decodeOp[SetResetAccountOperation],
+	"decline_voting_rights":          decodeOp[DeclineVotingRightsOperation],
+	"custom":                         decodeOp[CustomOperation],
+	"custom_binary":                  decodeOp[CustomBinaryOperation],
+	"pow":                            decodeOp[PowOperation],
+	"pow2":                           decodeOp[Pow2Operation],
+	"report_over_production":         decodeOp[ReportOverProductionOperation],
+}
+
+func decodeHiveOp(name string, data []byte) (HiveOperation, error) {
+	decoder, ok := hiveOpDecoders[name]
+	if !ok {
+		return nil, errors.New("unsupported operation: " + name)
+	}
+	return decoder(data)
+}
+
+// extensionValue returns the value of a static_variant extension given in
+// either the condenser [tag, value] or the appbase {"type", "value"} form.
+func extensionValue(ext json.RawMessage) (json.RawMessage, error) {
+	var pair []json.RawMessage
+	if json.Unmarshal(ext, &pair) == nil && len(pair) == 2 {
+		return pair[1], nil
+	}
+
+	var typed struct {
+		Value json.RawMessage `json:"value"`
+	}
+	err := json.Unmarshal(ext, &typed)
+	if err != nil {
+		return nil, err
+	}
+	return typed.Value, nil
 }
 
 func getHiveChainId() []byte {
@@ -166,3 +273,54 @@ func getHiveOpIds() map[string]uint64 {
 
 	return hiveOpsIds
 }
+
+// getHiveVirtualOpIds returns the ids of the virtual operations, which the
+// chain numbers after the last regular operation.
+func getHiveVirtualOpIds() map[string]uint64 {
+	virtualOpsIds := make(map[string]uint64)
+	virtualOpsIds["fill_convert_request_operation"] = 50
+	virtualOpsIds["author_reward_operation"] = 51
+	virtualOpsIds["curation_reward_operation"] = 52
+	virtualOpsIds["comment_reward_operation"] = 53
+	virtualOpsIds["liquidity_reward_operation"] = 54
+	virtualOpsIds["interest_operation"] = 55
+	virtualOpsIds["fill_vesting_withdraw_operation"] = 56
+	virtualOpsIds["fill_order_operation"] = 57
+	virtualOpsIds["shutdown_witness_operation"] = 58
+	virtualOpsIds["fill_transfer_from_savings_operation"] = 59
+	virtualOpsIds["hardfork_operation"] = 60
+	virtualOpsIds["comment_payout_update_operation"] = 61
+	virtualOpsIds["return_vesting_delegation_operation"] = 62
+	virtualOpsIds["comment_benefactor_reward_operation"] = 63
+	virtualOpsIds["producer_reward_operation"] = 64
+	virtualOpsIds["clear_null_account_balance_operation"] = 65
+	virtualOpsIds["proposal_pay_operation"] = 66
+	virtualOpsIds["dhf_funding_operation"] = 67
+	virtualOpsIds["hardfork_hive_operation"] = 68
+	virtualOpsIds["hardfork_hive_restore_operation"] = 69
+	virtualOpsIds["delayed_voting_operation"] = 70
+	virtualOpsIds["consolidate_treasury_balance_operation"] = 71
+	virtualOpsIds["effective_comment_vote_operation"] = 72
+	virtualOpsIds["ineffective_delete_comment_operation"] = 73
+	virtualOpsIds["dhf_conversion_operation"] = 74
+	virtualOpsIds["expired_account_notification_operation"] = 75
+	virtualOpsIds["changed_recovery_account_operation"] = 76
+	virtualOpsIds["transfer_to_vesting_completed_operation"] = 77
+	virtualOpsIds["pow_reward_operation"] = 78
+	virtualOpsIds["vesting_shares_split_operation"] = 79
+	virtualOpsIds["account_created_operation"] = 80
+	virtualOpsIds["fill_collateralized_convert_request_operation"] = 81
+	virtualOpsIds["system_warning_operation"] = 82
+	virtualOpsIds["fill_recurrent_transfer_operation"] = 83
+	virtualOpsIds["failed_recurrent_transfer_operation"] = 84
+	virtualOpsIds["limit_order_cancelled_operation"] = 85
+	virtualOpsIds["producer_missed_operation"] = 86
+	virtualOpsIds["proposal_fee_operation"] = 87
+	virtualOpsIds["collateralized_convert_immediate_conversion_operation"] = 88
+	virtualOpsIds["escrow_approved_operation"] = 89
+	virtualOpsIds["escrow_rejected_operation"] = 90
+	virtualOpsIds["proxy_cleared_operation"] = 91
+	virtualOpsIds["declined_voting_rights_operation"] = 92
+
+	return virtualOpsIds
+}
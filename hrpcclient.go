@@ -15,9 +15,11 @@ type HiveRpcNode struct {
 }
 
 type globalProps struct {
-	HeadBlockNumber int    `json:"head_block_number"`
-	HeadBlockId     string `json:"head_block_id"`
-	Time            string `json:"time"`
+	HeadBlockNumber      int    `json:"head_block_number"`
+	HeadBlockId          string `json:"head_block_id"`
+	Time                 string `json:"time"`
+	TotalVestingFundHive string `json:"total_vesting_fund_hive"`
+	TotalVestingShares   string `json:"total_vesting_shares"`
 }
 
 type hrpcQuery struct {
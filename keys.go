@@ -2,7 +2,10 @@ package hivego
 
 import (
 	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
 	"errors"
+	"strings"
 
 	"github.com/decred/base58"
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
@@ -31,6 +34,58 @@ func KeyPairFromWif(wif string) (*KeyPair, error) {
 	return &KeyPair{prvKey, pubKey}, nil
 }
 
+// KeyPairFromSeed derives a KeyPair whose private key is the sha256 of seed
+func KeyPairFromSeed(seed string) *KeyPair {
+	hash := sha256.Sum256([]byte(seed))
+	prvKey, pubKey := secp256k1.PrivKeyFromBytes(hash[:])
+
+	return &KeyPair{prvKey, pubKey}
+}
+
+// Encodes the private key of the KeyPair as a WIF string
+func (kp *KeyPair) GetWif() string {
+	payload := append([]byte{0x80}, kp.PrivateKey.Serialize()...)
+	sum := checksum(payload)
+
+	return base58.Encode(append(payload, sum[:]...))
+}
+
+// AccountKeys is the set of keys of an account derived from its master
+// password, the same way the Hive wallets derive them.
+type AccountKeys struct {
+	Password string
+	Owner    *KeyPair
+	Active   *KeyPair
+	Posting  *KeyPair
+	Memo     *KeyPair
+}
+
+// AccountKeysFromPassword derives the owner, active, posting and memo keys of
+// account from its master password.
+func AccountKeysFromPassword(account string, password string) *AccountKeys {
+	return &AccountKeys{
+		Password: password,
+		Owner:    KeyPairFromSeed(account + "owner" + password),
+		Active:   KeyPairFromSeed(account + "active" + password),
+		Posting:  KeyPairFromSeed(account + "posting" + password),
+		Memo:     KeyPairFromSeed(account + "memo" + password),
+	}
+}
+
+// GenerateAccountKeys creates a random master password for a new account and derives its keys.
+func GenerateAccountKeys(account string) (*AccountKeys, error) {
+	seed := make([]byte, 32)
+	_, err := rand.Read(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	prvKey, pubKey := secp256k1.PrivKeyFromBytes(seed)
+	password := "P" + (&KeyPair{prvKey, pubKey}).GetWif()
+
+	return AccountKeysFromPassword(account, password), nil
+}
+
 // Decodes a base58 Hive public key to secp256k1 public key
 func DecodePublicKey(pubKey string) (*secp256k1.PublicKey, error) {
 	// check prefix matches
@@ -82,28 +137,44 @@ func GetPublicKeyString(pubKey *secp256k1.PublicKey) *string {
 		return nil
 	}
 
-	pubKeyBytes := pubKey.SerializeCompressed()
+	encoded := publicKeyStringFromBytes(pubKey.SerializeCompressed())
+	return &encoded
+}
 
+// publicKeyStringFromBytes encodes a 33 byte compressed public key as a prefixed base58 string.
+func publicKeyStringFromBytes(pubKeyBytes []byte) string {
 	// get ripemd160 hash
 	hasher := ripemd160.New()
-	_, err := hasher.Write(pubKeyBytes)
-
-	if err != nil {
-		return nil
-	}
+	hasher.Write(pubKeyBytes)
 
 	// get checksum
 	checksum := hasher.Sum(nil)[:4]
 
 	// append checksum to public key
+	keyWithChecksum := append(append([]byte{}, pubKeyBytes...), checksum...)
 
-	pubKeyBytes = append(pubKeyBytes, checksum...)
+	// encode to base58 and add prefix
+	return PublicKeyPrefix + base58.Encode(keyWithChecksum)
+}
 
-	// encode to base58
-	encoded := base58.Encode(pubKeyBytes)
+// publicKeyBytes decodes a prefixed base58 public key to its 33 byte compressed
+// form without parsing the curve point, so that the null key is accepted.
+func publicKeyBytes(pubKey string) ([]byte, error) {
+	if !strings.HasPrefix(pubKey, PublicKeyPrefix) {
+		return nil, errors.New("invalid prefix")
+	}
 
-	// add prefix
-	encoded = PublicKeyPrefix + encoded
+	decoded := base58.Decode(pubKey[len(PublicKeyPrefix):])
+	if len(decoded) != 37 {
+		return nil, errors.New("invalid public key length")
+	}
 
-	return &encoded
+	pubKeyBytes := decoded[:33]
+	hasher := ripemd160.New()
+	hasher.Write(pubKeyBytes)
+	if !bytes.Equal(decoded[33:], hasher.Sum(nil)[:4]) {
+		return nil, errors.New("checksums do not match")
+	}
+
+	return pubKeyBytes, nil
 }
@@ -61,3 +61,34 @@ func TestGetPublicKeyString(t *testing.T) {
 		t.Errorf("Public Key string %s does not match expected string %s", *pubKeyString, pubKeyStringExpected)
 	}
 }
+
+func TestGetWif(t *testing.T) {
+	wif := "5JUvJcF6rQvFbZLtDFagreKCYWWcHpHApy7sbRHZ6PeZYNftLh6"
+	keyPair, err := hivego.KeyPairFromWif(wif)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := keyPair.GetWif(); got != wif {
+		t.Errorf("WIF %s does not match expected WIF %s", got, wif)
+	}
+}
+
+func TestGenerateAccountKeys(t *testing.T) {
+	keys, err := hivego.GenerateAccountKeys("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if keys.Password[0] != 'P' {
+		t.Errorf("Password %s does not start with P", keys.Password)
+	}
+
+	derived := hivego.AccountKeysFromPassword("alice", keys.Password)
+	if *derived.Owner.GetPublicKeyString() != *keys.Owner.GetPublicKeyString() {
+		t.Error("Owner key is not derived from the password")
+	}
+	if *keys.Owner.GetPublicKeyString() == *keys.Active.GetPublicKeyString() {
+		t.Error("Owner and active keys should differ")
+	}
+}
@@ -0,0 +1,187 @@
+package hivego
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// Price is an exchange rate between two legacy asset strings, such as
+// base "0.250 HBD" and quote "1.000 HIVE". It is the form operations take;
+// api results decode into types.Price, and PriceFromTypes and Price.Types
+// convert between the two.
+type Price struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+}
+
+// PriceFromTypes converts a price read from the api into the form operations take.
+func PriceFromTypes(price types.Price) Price {
+	return Price{Base: price.Base.String(), Quote: price.Quote.String()}
+}
+
+// Types parses the price into the form api results decode into.
+func (p Price) Types() (types.Price, error) {
+	base, err := assetOf(p.Base)
+	if err != nil {
+		return types.Price{}, err
+	}
+	quote, err := assetOf(p.Quote)
+	if err != nil {
+		return types.Price{}, err
+	}
+	return types.Price{Base: base, Quote: quote}, nil
+}
+
+func assetOf(asset string) (types.Asset, error) {
+	amount, precision, symbol, err := parseAsset(asset)
+	if err != nil {
+		return types.Asset{}, err
+	}
+	return types.Asset{Amount: amount, Precision: precision, Symbol: symbol}, nil
+}
+
+type LimitOrderCreateOperation struct {
+	Owner        string `json:"owner"`
+	OrderId      uint32 `json:"orderid"`
+	AmountToSell string `json:"amount_to_sell"`
+	MinToReceive string `json:"min_to_receive"`
+	FillOrKill   bool   `json:"fill_or_kill"`
+	Expiration   string `json:"expiration"`
+}
+
+func (o LimitOrderCreateOperation) opName() string {
+	return "limit_order_create"
+}
+
+func (o LimitOrderCreateOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Owner}}
+}
+
+// LimitOrderCreate places an order selling amountToSell for at least minToReceive.
+func (h *HiveRpcNode) LimitOrderCreate(owner string, orderId uint32, amountToSell string, minToReceive string, fillOrKill bool, expiration time.Time, wif *string) (string, error) {
+	op := LimitOrderCreateOperation{owner, orderId, amountToSell, minToReceive, fillOrKill, expiration.UTC().Format(hiveTimeLayout)}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type LimitOrderCreate2Operation struct {
+	Owner        string `json:"owner"`
+	OrderId      uint32 `json:"orderid"`
+	AmountToSell string `json:"amount_to_sell"`
+	FillOrKill   bool   `json:"fill_or_kill"`
+	ExchangeRate Price  `json:"exchange_rate"`
+	Expiration   string `json:"expiration"`
+}
+
+func (o LimitOrderCreate2Operation) opName() string {
+	return "limit_order_create2"
+}
+
+func (o LimitOrderCreate2Operation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Owner}}
+}
+
+// LimitOrderCreate2 places an order selling amountToSell at exchangeRate,
+// whose base must be in the symbol being sold.
+func (h *HiveRpcNode) LimitOrderCreate2(owner string, orderId uint32, amountToSell string, exchangeRate Price, fillOrKill bool, expiration time.Time, wif *string) (string, error) {
+	op := LimitOrderCreate2Operation{owner, orderId, amountToSell, fillOrKill, exchangeRate, expiration.UTC().Format(hiveTimeLayout)}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type LimitOrderCancelOperation struct {
+	Owner   string `json:"owner"`
+	OrderId uint32 `json:"orderid"`
+}
+
+func (o LimitOrderCancelOperation) opName() string {
+	return "limit_order_cancel"
+}
+
+func (o LimitOrderCancelOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Owner}}
+}
+
+func (h *HiveRpcNode) LimitOrderCancel(owner string, orderId uint32, wif *string) (string, error) {
+	op := LimitOrderCancelOperation{owner, orderId}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type orderBookParams struct {
+	Limit int `json:"limit"`
+}
+
+// GetOrderBook returns up to limit bids and asks of the internal market.
+func (h *HiveRpcNode) GetOrderBook(limit int) (types.OrderBook, error) {
+	var query = hrpcQuery{method: "market_history_api.get_order_book", params: orderBookParams{limit}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.OrderBook{}, err
+	}
+
+	var orderBook types.OrderBook
+	err = json.Unmarshal(res, &orderBook)
+	if err != nil {
+		return types.OrderBook{}, err
+	}
+	return orderBook, nil
+}
+
+// GetOpenOrders lists the open orders of account.
+func (h *HiveRpcNode) GetOpenOrders(account string) ([]types.OpenOrder, error) {
+	var query = hrpcQuery{method: "condenser_api.get_open_orders", params: []string{account}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []types.OpenOrder
+	err = json.Unmarshal(res, &orders)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (h *HiveRpcNode) GetTicker() (types.Ticker, error) {
+	var query = hrpcQuery{method: "market_history_api.get_ticker", params: struct{}{}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.Ticker{}, err
+	}
+
+	var ticker types.Ticker
+	err = json.Unmarshal(res, &ticker)
+	if err != nil {
+		return types.Ticker{}, err
+	}
+	return ticker, nil
+}
+
+type tradeHistoryParams struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Limit int    `json:"limit"`
+}
+
+// GetTradeHistory returns up to limit trades filled between start and end.
+func (h *HiveRpcNode) GetTradeHistory(start time.Time, end time.Time, limit int) ([]types.MarketTrade, error) {
+	params := tradeHistoryParams{start.UTC().Format(hiveTimeLayout), end.UTC().Format(hiveTimeLayout), limit}
+	var query = hrpcQuery{method: "market_history_api.get_trade_history", params: params}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var history struct {
+		Trades []types.MarketTrade `json:"trades"`
+	}
+	err = json.Unmarshal(res, &history)
+	if err != nil {
+		return nil, err
+	}
+	return history.Trades, nil
+}
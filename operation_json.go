@@ -0,0 +1,381 @@
+package hivego
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// MarshalOperation encodes op in the condenser [name, value] form.
+func MarshalOperation(op HiveOperation) ([]byte, error) {
+	return json.Marshal([2]interface{}{op.opName(), op})
+}
+
+// MarshalAppbaseOperation encodes op in the appbase {"type", "value"} form,
+// with assets as NAI objects and extensions as {"type", "value"} objects.
+func MarshalAppbaseOperation(op HiveOperation) ([]byte, error) {
+	appbaseOp, err := EncodeOperation(types.Operation{Type: op.opName() + "_operation", Value: op})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(appbaseOp)
+}
+
+// UnmarshalOperation decodes an operation in either the condenser or the appbase form.
+func UnmarshalOperation(data []byte) (HiveOperation, error) {
+	var op types.Operation
+	err := json.Unmarshal(data, &op)
+	if err != nil {
+		return nil, err
+	}
+	op, err = DecodeOperation(op)
+	if err != nil {
+		return nil, err
+	}
+
+	hiveOp, ok := op.Value.(HiveOperation)
+	if !ok {
+		return nil, errors.New("unsupported operation: " + op.Type)
+	}
+	return hiveOp, nil
+}
+
+// DecodeOperation returns op with its Value decoded into the typed operation,
+// such as VoteOperation, or the typed virtual operation, such as
+// ProducerRewardOperation. Operations of other types are returned unchanged.
+// When the value does not fit the typed operation, for example because of an
+// extension added to the chain later, op is returned unchanged with the error.
+func DecodeOperation(op types.Operation) (types.Operation, error) {
+	raw := op.RawValue
+	if raw == nil {
+		var err error
+		raw, err = json.Marshal(op.Value)
+		if err != nil {
+			return op, err
+		}
+	}
+
+	value, err := decodeOperationValue(op.Type, raw)
+	if err != nil {
+		return op, err
+	}
+	if value != nil {
+		op.Value = value
+	}
+	return op, nil
+}
+
+// EncodeOperation returns op with a typed Value converted into its appbase
+// JSON form, with assets as NAI objects. Other values are returned unchanged.
+func EncodeOperation(op types.Operation) (types.Operation, error) {
+	name := strings.TrimSuffix(op.Type, "_operation")
+	if hiveOp, ok := op.Value.(HiveOperation); ok {
+		name = hiveOp.opName()
+	} else if _, ok := virtualOpDecoders[name]; !ok {
+		return op, nil
+	} else if _, ok := op.Value.(map[string]interface{}); ok {
+		return op, nil
+	}
+
+	value, err := appbaseValue(name, op.Value)
+	if err != nil {
+		return op, err
+	}
+	op.Value = value
+	op.RawValue = nil
+	return op, nil
+}
+
+// decodeBlockOperations decodes the operations of block in place. An
+// operation that does not decode keeps its generic map value, so that one
+// such operation does not fail a whole block.
+func decodeBlockOperations(block *types.Block) {
+	for i := range block.Transactions {
+		ops := block.Transactions[i].Operations
+		for j := range ops {
+			if op, err := DecodeOperation(ops[j]); err == nil {
+				ops[j] = op
+			}
+		}
+	}
+}
+
+// decodeOperationObjects decodes the operations of objs in place, like decodeBlockOperations.
+func decodeOperationObjects(objs []types.OperationObject) {
+	for i := range objs {
+		if op, err := DecodeOperation(objs[i].Op); err == nil {
+			objs[i].Op = op
+		}
+	}
+}
+
+// decodeOperationValue decodes an operation value in either form into its
+// typed operation or virtual operation. It returns nil for unknown operation types.
+func decodeOperationValue(opType string, value []byte) (interface{}, error) {
+	name := strings.TrimSuffix(opType, "_operation")
+	_, isHiveOp := hiveOpDecoders[name]
+	virtualDecoder, isVirtualOp := virtualOpDecoders[name]
+	if !isHiveOp && !isVirtualOp {
+		return nil, nil
+	}
+
+	tree, err := decodeJsonTree(value)
+	if err != nil {
+		return nil, err
+	}
+	legacy, err := legacyAssetsFromAppbase(tree)
+	if err != nil {
+		return nil, err
+	}
+	legacyJs, err := json.Marshal(legacy)
+	if err != nil {
+		return nil, err
+	}
+
+	if isVirtualOp {
+		return virtualDecoder(legacyJs)
+	}
+	return decodeHiveOp(name, legacyJs)
+}
+
+// decodeJsonTree decodes JSON into maps and slices, keeping numbers as
+// json.Number so that 64 bit values are not rounded.
+func decodeJsonTree(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var tree interface{}
+	err := decoder.Decode(&tree)
+	return tree, err
+}
+
+// appbaseSchema lists the fields of an operation, by dotted JSON path, that
+// differ between the condenser and the appbase form: assets, and static
+// variants with the type names of their tags.
+type appbaseSchema struct {
+	assets   []string
+	variants map[string][]string
+}
+
+var appbaseSchemas = map[string]appbaseSchema{
+	"transfer":                       {assets: []string{"amount"}},
+	"transfer_to_vesting":            {assets: []string{"amount"}},
+	"withdraw_vesting":               {assets: []string{"vesting_shares"}},
+	"limit_order_create":             {assets: []string{"amount_to_sell", "min_to_receive"}},
+	"feed_publish":                   {assets: []string{"exchange_rate.base", "exchange_rate.quote"}},
+	"convert":                        {assets: []string{"amount"}},
+	"account_create":                 {assets: []string{"fee"}},
+	"witness_update":                 {assets: []string{"props.account_creation_fee", "fee"}},
+	"pow":                            {assets: []string{"props.account_creation_fee"}},
+	"limit_order_create2":            {assets: []string{"amount_to_sell", "exchange_rate.base", "exchange_rate.quote"}},
+	"claim_account":                  {assets: []string{"fee"}},
+	"escrow_transfer":                {assets: []string{"hbd_amount", "hive_amount", "fee"}},
+	"escrow_release":                 {assets: []string{"hbd_amount", "hive_amount"}},
+	"transfer_to_savings":            {assets: []string{"amount"}},
+	"transfer_from_savings":          {assets: []string{"amount"}},
+	"claim_reward_balance":           {assets: []string{"reward_hive", "reward_hbd", "reward_vests"}},
+	"delegate_vesting_shares":        {assets: []string{"vesting_shares"}},
+	"account_create_with_delegation": {assets: []string{"fee", "delegation"}},
+	"create_proposal":                {assets: []string{"daily_pay"}},
+	"collateralized_convert":         {assets: []string{"amount"}},
+	"comment_options": {
+		assets:   []string{"max_accepted_payout"},
+		variants: map[string][]string{"extensions": {"comment_payout_beneficiaries"}},
+	},
+	"update_proposal": {
+		assets:   []string{"daily_pay"},
+		variants: map[string][]string{"extensions": {"void_t", "update_proposal_end_date"}},
+	},
+	"recurrent_transfer": {
+		assets:   []string{"amount"},
+		variants: map[string][]string{"extensions": {"recurrent_transfer_pair_id"}},
+	},
+	"pow2": {
+		assets:   []string{"props.account_creation_fee"},
+		variants: map[string][]string{"work": pow2WorkTypes},
+	},
+	"report_over_production": {
+		variants: map[string][]string{
+			"first_block.extensions":  blockHeaderExtensionTypes,
+			"second_block.extensions": blockHeaderExtensionTypes,
+		},
+	},
+
+	// virtual operations
+	"fill_convert_request":                {assets: []string{"amount_in", "amount_out"}},
+	"author_reward":                       {assets: []string{"hbd_payout", "hive_payout", "vesting_payout", "curators_vesting_payout"}},
+	"curation_reward":                     {assets: []string{"reward"}},
+	"comment_reward":                      {assets: []string{"payout", "total_payout_value", "curator_payout_value", "beneficiary_payout_value"}},
+	"liquidity_reward":                    {assets: []string{"payout"}},
+	"interest":                            {assets: []string{"interest"}},
+	"fill_vesting_withdraw":               {assets: []string{"withdrawn", "deposited"}},
+	"fill_order":                          {assets: []string{"current_pays", "open_pays"}},
+	"fill_transfer_from_savings":          {assets: []string{"amount"}},
+	"return_vesting_delegation":           {assets: []string{"vesting_shares"}},
+	"comment_benefactor_reward":           {assets: []string{"hbd_payout", "hive_payout", "vesting_payout"}},
+	"producer_reward":                     {assets: []string{"vesting_shares"}},
+	"proposal_pay":                        {assets: []string{"payment"}},
+	"transfer_to_vesting_completed":       {assets: []string{"hive_vested", "vesting_shares_received"}},
+	"account_created":                     {assets: []string{"initial_vesting_shares", "initial_delegation"}},
+	"fill_collateralized_convert_request": {assets: []string{"amount_in", "amount_out", "excess_collateral"}},
+	"fill_recurrent_transfer":             {assets: []string{"amount"}},
+	"failed_recurrent_transfer":           {assets: []string{"amount"}},
+}
+
+// appbaseValue returns the JSON value of the operation name in the appbase form.
+func appbaseValue(name string, op interface{}) (interface{}, error) {
+	opJs, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := decodeJsonTree(opJs)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := appbaseSchemas[name]
+	for _, path := range schema.assets {
+		tree, err = convertPath(tree, strings.Split(path, "."), naiAsset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for path, names := range schema.variants {
+		names := names
+		tree, err = convertPath(tree, strings.Split(path, "."), func(v interface{}) (interface{}, error) {
+			return appbaseVariants(v, names)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// convertPath replaces the value at path in tree with convert applied to it.
+// Arrays along the path are converted element by element, and missing fields are skipped.
+func convertPath(tree interface{}, path []string, convert func(interface{}) (interface{}, error)) (interface{}, error) {
+	if len(path) == 0 {
+		return convert(tree)
+	}
+
+	switch node := tree.(type) {
+	case map[string]interface{}:
+		child, ok := node[path[0]]
+		if !ok {
+			return tree, nil
+		}
+		converted, err := convertPath(child, path[1:], convert)
+		if err != nil {
+			return nil, err
+		}
+		node[path[0]] = converted
+	case []interface{}:
+		for i, item := range node {
+			converted, err := convertPath(item, path, convert)
+			if err != nil {
+				return nil, err
+			}
+			node[i] = converted
+		}
+	}
+	return tree, nil
+}
+
+// naiAsset converts a legacy asset string into an appbase {amount, precision, nai} object.
+func naiAsset(v interface{}) (interface{}, error) {
+	asset, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+
+	amount, precision, symbol, err := parseAsset(asset)
+	if err != nil {
+		return nil, err
+	}
+	for nai, naiSymbol := range types.NaiSymbols {
+		if naiSymbol == symbol {
+			return map[string]interface{}{
+				"amount":    strconv.FormatInt(amount, 10),
+				"precision": precision,
+				"nai":       nai,
+			}, nil
+		}
+	}
+	return nil, errors.New("unknown asset symbol: " + symbol)
+}
+
+// appbaseVariants converts a static variant in the [tag, value] form, or an
+// array of them, into the {"type", "value"} form.
+func appbaseVariants(v interface{}, names []string) (interface{}, error) {
+	variant, ok := v.([]interface{})
+	if !ok || len(variant) == 0 {
+		return v, nil
+	}
+
+	if _, ok := variant[0].([]interface{}); ok {
+		for i, item := range variant {
+			converted, err := appbaseVariants(item, names)
+			if err != nil {
+				return nil, err
+			}
+			variant[i] = converted
+		}
+		return variant, nil
+	}
+
+	if len(variant) != 2 {
+		return nil, errors.New("invalid static variant")
+	}
+	tag, ok := variant[0].(json.Number)
+	if !ok {
+		return nil, errors.New("invalid static variant tag")
+	}
+	index, err := strconv.Atoi(tag.String())
+	if err != nil || index < 0 || index >= len(names) {
+		return nil, errors.New("unknown static variant tag " + tag.String())
+	}
+
+	return map[string]interface{}{"type": names[index], "value": variant[1]}, nil
+}
+
+// variantTag returns the tag and value of a static variant given in either the
+// condenser [tag, value] or the appbase {"type", "value"} form, where names
+// lists the type names of the tags.
+func variantTag(data json.RawMessage, names []string) (int, json.RawMessage, error) {
+	var pair []json.RawMessage
+	if json.Unmarshal(data, &pair) == nil {
+		if len(pair) != 2 {
+			return 0, nil, errors.New("invalid static variant: " + string(data))
+		}
+		var tag int
+		err := json.Unmarshal(pair[0], &tag)
+		if err != nil {
+			return 0, nil, err
+		}
+		if tag < 0 || tag >= len(names) {
+			return 0, nil, errors.New("unknown static variant tag " + strconv.Itoa(tag))
+		}
+		return tag, pair[1], nil
+	}
+
+	var typed struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	err := json.Unmarshal(data, &typed)
+	if err != nil {
+		return 0, nil, err
+	}
+	for tag, name := range names {
+		if name == typed.Type {
+			return tag, typed.Value, nil
+		}
+	}
+	return 0, nil, errors.New("unknown static variant type " + typed.Type)
+}
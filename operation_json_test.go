@@ -0,0 +1,153 @@
+package hivego
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestTestOpsCoverAllOperations(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, op := range getTestOps() {
+		covered[op.opName()+"_operation"] = true
+	}
+
+	for name := range getHiveOpIds() {
+		if !covered[name] {
+			t.Error("No test operation for", name)
+		}
+		if _, ok := hiveOpDecoders[strings.TrimSuffix(name, "_operation")]; !ok {
+			t.Error("No decoder for", name)
+		}
+	}
+}
+
+func TestOperationJsonRoundTrip(t *testing.T) {
+	for _, op := range getTestOps() {
+		expected, err := op.serializeOp()
+		if err != nil {
+			t.Fatal(op.opName(), err)
+		}
+
+		for format, marshal := range map[string]func(HiveOperation) ([]byte, error){
+			"condenser": MarshalOperation,
+			"appbase":   MarshalAppbaseOperation,
+		} {
+			opJs, err := marshal(op)
+			if err != nil {
+				t.Fatal(op.opName(), format, err)
+			}
+
+			decoded, err := UnmarshalOperation(opJs)
+			if err != nil {
+				t.Fatal(op.opName(), format, err, string(opJs))
+			}
+
+			got, err := decoded.serializeOp()
+			if err != nil {
+				t.Fatal(op.opName(), format, err)
+			}
+			if !bytes.Equal(got, expected) {
+				t.Error(op.opName(), format, "did not round trip:", string(opJs))
+			}
+		}
+	}
+}
+
+func TestMarshalAppbaseOperation(t *testing.T) {
+	op := TransferOperation{From: "alice", To: "bob", Amount: "1.500 HBD", Memo: "1.000 HIVE"}
+	got, err := MarshalAppbaseOperation(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"type":"transfer_operation","value":{"amount":{"amount":"1500","nai":"@@000000013","precision":3},"from":"alice","memo":"1.000 HIVE","to":"bob"}}`
+	if string(got) != expected {
+		t.Error("Expected", expected, "got", string(got))
+	}
+
+	options := CommentOptionsOperation{Author: "alice", Permlink: "post", MaxAcceptedPayout: "0.000 HBD", Beneficiaries: []Beneficiary{{"bob", 500}}}
+	got, err = MarshalAppbaseOperation(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedExt := `"extensions":[{"type":"comment_payout_beneficiaries","value":{"beneficiaries":[{"account":"bob","weight":500}]}}]`
+	if !strings.Contains(string(got), expectedExt) {
+		t.Error("Expected", expectedExt, "in", string(got))
+	}
+}
+
+func TestBlockOperationsDecode(t *testing.T) {
+	txJs := `{"operations":[
+		{"type":"vote_operation","value":{"voter":"alice","author":"bob","permlink":"post","weight":10000}},
+		["transfer",{"from":"alice","to":"bob","amount":"1.000 HIVE","memo":""}],
+		{"type":"future_operation","value":{"field":1}}
+	]}`
+
+	var block types.Block
+	if err := json.Unmarshal([]byte(`{"transactions":[`+txJs+`]}`), &block); err != nil {
+		t.Fatal(err)
+	}
+	decodeBlockOperations(&block)
+	tx := block.Transactions[0]
+
+	vote, ok := tx.Operations[0].Value.(VoteOperation)
+	if !ok || vote.Voter != "alice" || vote.Weight != 10000 {
+		t.Error("Expected a VoteOperation, got", tx.Operations[0].Value)
+	}
+
+	transfer, ok := tx.Operations[1].Value.(TransferOperation)
+	if tx.Operations[1].Type != "transfer_operation" || !ok || transfer.Amount != "1.000 HIVE" {
+		t.Error("Expected a TransferOperation, got", tx.Operations[1])
+	}
+
+	if _, ok := tx.Operations[2].Value.(map[string]interface{}); !ok {
+		t.Error("Expected an unknown operation to decode into a map, got", tx.Operations[2].Value)
+	}
+}
+
+func TestBlockOperationsDecodeFallback(t *testing.T) {
+	var keyBuf bytes.Buffer
+	err := appendPublicKey(testKeyA, &keyBuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := hex.EncodeToString(keyBuf.Bytes())
+
+	blockJs := `{"block_id":"04c4b4004ac8b5f4b1a3e6e4b8aa1c9e4c5e2d7f","transactions":[{"operations":[
+		{"type":"witness_set_properties_operation","value":{"owner":"alice","props":[["account_subsidy_budget","1d030000"],["account_subsidy_decay","b94c0500"],["key","` + key + `"]],"extensions":[]}},
+		{"type":"pow2_operation","value":{"work":{"type":"future_pow","value":{}},"props":{"account_creation_fee":"3.000 HIVE","maximum_block_size":65536,"hbd_interest_rate":0}}}
+	]}]}`
+
+	var block types.Block
+	if err := json.Unmarshal([]byte(blockJs), &block); err != nil {
+		t.Fatal(err)
+	}
+	decodeBlockOperations(&block)
+	ops := block.Transactions[0].Operations
+
+	props, ok := ops[0].Value.(WitnessSetPropertiesOperation)
+	if !ok {
+		t.Fatal("Expected a WitnessSetPropertiesOperation, got", ops[0].Value)
+	}
+	if props.Props.Key != testKeyA || props.Props.Other["account_subsidy_budget"] != "1d030000" || props.Props.Other["account_subsidy_decay"] != "b94c0500" {
+		t.Error("Got props", props.Props)
+	}
+	serialized, err := props.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(serialized, []byte("account_subsidy_budget\x04\x1d\x03\x00\x00")) {
+		t.Error("Expected the unknown prop to be serialized back, got", hex.EncodeToString(serialized))
+	}
+
+	if _, ok := ops[1].Value.(map[string]interface{}); !ok || ops[1].Type != "pow2_operation" {
+		t.Error("Expected an undecodable operation to decode into a map, got", ops[1])
+	}
+	if _, err := DecodeOperation(ops[1]); err == nil {
+		t.Error("Expected DecodeOperation to report the undecodable operation")
+	}
+}
@@ -0,0 +1,258 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// The operations in this file are disabled on chain. They are kept so that
+// old blocks can be decoded, serialized and verified.
+
+// PowWork is the proof of work of a pow operation. The hashes and the
+// signature are hex encoded.
+type PowWork struct {
+	Worker    string `json:"worker"`
+	Input     string `json:"input"`
+	Signature string `json:"signature"`
+	Work      string `json:"work"`
+}
+
+type PowOperation struct {
+	WorkerAccount string          `json:"worker_account"`
+	BlockId       string          `json:"block_id"`
+	Nonce         uint64          `json:"nonce"`
+	Work          PowWork         `json:"work"`
+	Props         ChainProperties `json:"props"`
+}
+
+func (o PowOperation) opName() string {
+	return "pow"
+}
+
+func (o PowOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.WorkerAccount}}
+}
+
+func (o *PowOperation) UnmarshalJSON(data []byte) error {
+	type plainOp PowOperation
+	var raw struct {
+		plainOp
+		Nonce json.Number `json:"nonce"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*o = PowOperation(raw.plainOp)
+	o.Nonce, err = parseNonce(raw.Nonce)
+	return err
+}
+
+// parseNonce reads a uint64 given either as a number or, as the API does for
+// values above 32 bits, as a string.
+func parseNonce(nonce json.Number) (uint64, error) {
+	if nonce == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(nonce.String(), 10, 64)
+}
+
+type Pow2Input struct {
+	WorkerAccount string `json:"worker_account"`
+	PrevBlock     string `json:"prev_block"`
+	Nonce         uint64 `json:"nonce"`
+}
+
+func (i *Pow2Input) UnmarshalJSON(data []byte) error {
+	type plainInput Pow2Input
+	var raw struct {
+		plainInput
+		Nonce json.Number `json:"nonce"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*i = Pow2Input(raw.plainInput)
+	i.Nonce, err = parseNonce(raw.Nonce)
+	return err
+}
+
+type Pow2 struct {
+	Input      Pow2Input `json:"input"`
+	PowSummary uint32    `json:"pow_summary"`
+}
+
+type EquihashProof struct {
+	N      uint32   `json:"n"`
+	K      uint32   `json:"k"`
+	Seed   string   `json:"seed"`
+	Inputs []uint32 `json:"inputs"`
+}
+
+type EquihashPow struct {
+	Input      Pow2Input     `json:"input"`
+	Proof      EquihashProof `json:"proof"`
+	PrevBlock  string        `json:"prev_block"`
+	PowSummary uint32        `json:"pow_summary"`
+}
+
+// Pow2Work is the work of a pow2 operation. Exactly one of Pow2 and EquihashPow is set.
+type Pow2Work struct {
+	Pow2        *Pow2
+	EquihashPow *EquihashPow
+}
+
+var pow2WorkTypes = []string{"pow2", "equihash_pow"}
+
+func (w Pow2Work) input() Pow2Input {
+	if w.EquihashPow != nil {
+		return w.EquihashPow.Input
+	}
+	if w.Pow2 != nil {
+		return w.Pow2.Input
+	}
+	return Pow2Input{}
+}
+
+func (w Pow2Work) MarshalJSON() ([]byte, error) {
+	switch {
+	case w.Pow2 != nil:
+		return json.Marshal([]interface{}{0, w.Pow2})
+	case w.EquihashPow != nil:
+		return json.Marshal([]interface{}{1, w.EquihashPow})
+	}
+	return nil, errors.New("pow2 work not set")
+}
+
+func (w *Pow2Work) UnmarshalJSON(data []byte) error {
+	tag, value, err := variantTag(data, pow2WorkTypes)
+	if err != nil {
+		return err
+	}
+
+	*w = Pow2Work{}
+	if tag == 0 {
+		w.Pow2 = &Pow2{}
+		return json.Unmarshal(value, w.Pow2)
+	}
+	w.EquihashPow = &EquihashPow{}
+	return json.Unmarshal(value, w.EquihashPow)
+}
+
+type Pow2Operation struct {
+	Work        Pow2Work        `json:"work"`
+	NewOwnerKey *string         `json:"new_owner_key,omitempty"`
+	Props       ChainProperties `json:"props"`
+}
+
+func (o Pow2Operation) opName() string {
+	return "pow2"
+}
+
+// requiredAuths needs the new owner key when the pow2 creates an account, and
+// the worker's active authority otherwise.
+func (o Pow2Operation) requiredAuths() RequiredAuthorities {
+	if o.NewOwnerKey != nil {
+		return RequiredAuthorities{Other: []types.Authority{NewKeyAuthority(*o.NewOwnerKey).toAccountAuthority()}}
+	}
+	return RequiredAuthorities{Active: []string{o.Work.input().WorkerAccount}}
+}
+
+type HardforkVersionVote struct {
+	HfVersion string `json:"hf_version"`
+	HfTime    string `json:"hf_time"`
+}
+
+// BlockHeaderExtension is one extension of a block header. At most one field
+// is set; when neither is, the extension is void_t.
+type BlockHeaderExtension struct {
+	Version             *string
+	HardforkVersionVote *HardforkVersionVote
+}
+
+var blockHeaderExtensionTypes = []string{"void_t", "version", "hardfork_version_vote"}
+
+func (e BlockHeaderExtension) MarshalJSON() ([]byte, error) {
+	switch {
+	case e.Version != nil:
+		return json.Marshal([]interface{}{1, *e.Version})
+	case e.HardforkVersionVote != nil:
+		return json.Marshal([]interface{}{2, e.HardforkVersionVote})
+	}
+	return json.Marshal([]interface{}{0, struct{}{}})
+}
+
+func (e *BlockHeaderExtension) UnmarshalJSON(data []byte) error {
+	tag, value, err := variantTag(data, blockHeaderExtensionTypes)
+	if err != nil {
+		return err
+	}
+
+	*e = BlockHeaderExtension{}
+	switch tag {
+	case 1:
+		e.Version = new(string)
+		return json.Unmarshal(value, e.Version)
+	case 2:
+		e.HardforkVersionVote = &HardforkVersionVote{}
+		return json.Unmarshal(value, e.HardforkVersionVote)
+	}
+	return nil
+}
+
+// parseVersion packs a "major.hardfork.revision" version string into its uint32 form.
+func parseVersion(version string) (uint32, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, errors.New("invalid version: " + version)
+	}
+
+	major, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	hardfork, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	var revision uint64
+	if len(parts) == 3 {
+		revision, err = strconv.ParseUint(parts[2], 10, 16)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return uint32(major<<24 | hardfork<<16 | revision), nil
+}
+
+type SignedBlockHeader struct {
+	Previous              string                 `json:"previous"`
+	Timestamp             string                 `json:"timestamp"`
+	Witness               string                 `json:"witness"`
+	TransactionMerkleRoot string                 `json:"transaction_merkle_root"`
+	Extensions            []BlockHeaderExtension `json:"extensions"`
+	WitnessSignature      string                 `json:"witness_signature"`
+}
+
+type ReportOverProductionOperation struct {
+	Reporter    string            `json:"reporter"`
+	FirstBlock  SignedBlockHeader `json:"first_block"`
+	SecondBlock SignedBlockHeader `json:"second_block"`
+}
+
+func (o ReportOverProductionOperation) opName() string {
+	return "report_over_production"
+}
+
+// requiredAuths is empty: the two conflicting headers signed by the witness are the proof.
+func (o ReportOverProductionOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{}
+}
@@ -0,0 +1,282 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+const maxProposalIdsPerVote = 5
+
+// Proposal statuses accepted by ListProposals.
+const (
+	ProposalStatusAll      = "all"
+	ProposalStatusInactive = "inactive"
+	ProposalStatusActive   = "active"
+	ProposalStatusExpired  = "expired"
+	ProposalStatusVotable  = "votable"
+)
+
+type CreateProposalOperation struct {
+	Creator   string `json:"creator"`
+	Receiver  string `json:"receiver"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	DailyPay  string `json:"daily_pay"`
+	Subject   string `json:"subject"`
+	Permlink  string `json:"permlink"`
+}
+
+func (o CreateProposalOperation) opName() string {
+	return "create_proposal"
+}
+
+func (o CreateProposalOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Creator}}
+}
+
+// CreateProposal creates a DHF proposal paying dailyPay HBD to receiver between
+// startDate and endDate. The permlink must refer to an existing post of creator.
+func (h *HiveRpcNode) CreateProposal(creator string, receiver string, startDate time.Time, endDate time.Time, dailyPay string, subject string, permlink string, wif *string) (string, error) {
+	op := CreateProposalOperation{
+		Creator:   creator,
+		Receiver:  receiver,
+		StartDate: startDate.UTC().Format(hiveTimeLayout),
+		EndDate:   endDate.UTC().Format(hiveTimeLayout),
+		DailyPay:  dailyPay,
+		Subject:   subject,
+		Permlink:  permlink,
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type UpdateProposalVotesOperation struct {
+	Voter       string  `json:"voter"`
+	ProposalIds []int64 `json:"proposal_ids"`
+	Approve     bool    `json:"approve"`
+}
+
+func (o UpdateProposalVotesOperation) opName() string {
+	return "update_proposal_votes"
+}
+
+func (o UpdateProposalVotesOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Voter}}
+}
+
+// UpdateProposalVotes approves or removes the votes of voter on up to five proposals.
+func (h *HiveRpcNode) UpdateProposalVotes(voter string, proposalIds []int64, approve bool, wif *string) (string, error) {
+	op := UpdateProposalVotesOperation{voter, proposalIds, approve}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type RemoveProposalOperation struct {
+	ProposalOwner string  `json:"proposal_owner"`
+	ProposalIds   []int64 `json:"proposal_ids"`
+}
+
+func (o RemoveProposalOperation) opName() string {
+	return "remove_proposal"
+}
+
+func (o RemoveProposalOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.ProposalOwner}}
+}
+
+func (h *HiveRpcNode) RemoveProposal(proposalOwner string, proposalIds []int64, wif *string) (string, error) {
+	op := RemoveProposalOperation{proposalOwner, proposalIds}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// UpdateProposalOperation lowers the daily pay or changes the subject and
+// permlink of a proposal. EndDate, when set, is sent in the
+// update_proposal_end_date extension and can only move the end date earlier.
+type UpdateProposalOperation struct {
+	ProposalId int64   `json:"proposal_id"`
+	Creator    string  `json:"creator"`
+	DailyPay   string  `json:"daily_pay"`
+	Subject    string  `json:"subject"`
+	Permlink   string  `json:"permlink"`
+	EndDate    *string `json:"-"`
+}
+
+func (o UpdateProposalOperation) opName() string {
+	return "update_proposal"
+}
+
+func (o UpdateProposalOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Creator}}
+}
+
+type updateProposalEndDate struct {
+	EndDate string `json:"end_date"`
+}
+
+func (o UpdateProposalOperation) MarshalJSON() ([]byte, error) {
+	type plainOp UpdateProposalOperation
+	extensions := []interface{}{}
+	if o.EndDate != nil {
+		// tag 0 is void_t, the end date extension is tag 1
+		extensions = append(extensions, []interface{}{1, updateProposalEndDate{*o.EndDate}})
+	}
+
+	return json.Marshal(struct {
+		plainOp
+		Extensions []interface{} `json:"extensions"`
+	}{plainOp(o), extensions})
+}
+
+func (o *UpdateProposalOperation) UnmarshalJSON(data []byte) error {
+	type plainOp UpdateProposalOperation
+	var raw struct {
+		plainOp
+		Extensions []json.RawMessage `json:"extensions"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*o = UpdateProposalOperation(raw.plainOp)
+	for _, ext := range raw.Extensions {
+		value, err := extensionValue(ext)
+		if err != nil {
+			return err
+		}
+
+		var e updateProposalEndDate
+		err = json.Unmarshal(value, &e)
+		if err != nil {
+			return err
+		}
+		if e.EndDate != "" {
+			o.EndDate = &e.EndDate
+		}
+	}
+
+	return nil
+}
+
+// UpdateProposal updates a proposal. endDate may be nil to keep the current end date.
+func (h *HiveRpcNode) UpdateProposal(proposalId int64, creator string, dailyPay string, subject string, permlink string, endDate *time.Time, wif *string) (string, error) {
+	op := UpdateProposalOperation{ProposalId: proposalId, Creator: creator, DailyPay: dailyPay, Subject: subject, Permlink: permlink}
+	if endDate != nil {
+		end := endDate.UTC().Format(hiveTimeLayout)
+		op.EndDate = &end
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// sortedProposalIds validates proposal ids and returns them sorted, as the chain stores them in a flat_set.
+func sortedProposalIds(ids []int64, max int) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("at least one proposal id is required")
+	}
+	if max > 0 && len(ids) > max {
+		return nil, errors.New("too many proposal ids")
+	}
+
+	sorted := make([]int64, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] == sorted[i-1] {
+			return nil, errors.New("duplicate proposal id")
+		}
+	}
+	return sorted, nil
+}
+
+type listProposalsParams struct {
+	Start          []interface{} `json:"start"`
+	Limit          int           `json:"limit"`
+	Order          string        `json:"order"`
+	OrderDirection string        `json:"order_direction"`
+	Status         string        `json:"status"`
+}
+
+// ListProposals lists proposals in the given order ("by_creator",
+// "by_start_date", "by_end_date" or "by_total_votes") and direction
+// ("ascending" or "descending"), filtered by status, starting at start.
+func (h *HiveRpcNode) ListProposals(start []interface{}, limit int, order string, orderDirection string, status string) ([]types.Proposal, error) {
+	if start == nil {
+		start = []interface{}{}
+	}
+	params := listProposalsParams{start, limit, order, orderDirection, status}
+	var query = hrpcQuery{method: "database_api.list_proposals", params: params}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Proposals []types.Proposal `json:"proposals"`
+	}
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Proposals, nil
+}
+
+// ListProposalsByTotalVotes lists proposals with the given status, most voted first.
+func (h *HiveRpcNode) ListProposalsByTotalVotes(status string, limit int) ([]types.Proposal, error) {
+	return h.ListProposals(nil, limit, "by_total_votes", "descending", status)
+}
+
+func (h *HiveRpcNode) listProposalVotes(start []interface{}, limit int, order string) ([]types.ProposalVote, error) {
+	params := listProposalsParams{start, limit, order, "ascending", ProposalStatusAll}
+	var query = hrpcQuery{method: "database_api.list_proposal_votes", params: params}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		ProposalVotes []types.ProposalVote `json:"proposal_votes"`
+	}
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ProposalVotes, nil
+}
+
+// ListProposalVoters lists up to limit votes on a proposal, starting at voter start.
+func (h *HiveRpcNode) ListProposalVoters(proposalId int64, start string, limit int) ([]types.ProposalVote, error) {
+	votes, err := h.listProposalVotes([]interface{}{proposalId, start}, limit, "by_proposal_voter")
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []types.ProposalVote
+	for _, vote := range votes {
+		if vote.Proposal.ProposalID == proposalId {
+			filtered = append(filtered, vote)
+		}
+	}
+	return filtered, nil
+}
+
+// ListVoterProposals lists up to limit proposal votes cast by voter.
+func (h *HiveRpcNode) ListVoterProposals(voter string, limit int) ([]types.ProposalVote, error) {
+	votes, err := h.listProposalVotes([]interface{}{voter}, limit, "by_voter_proposal")
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []types.ProposalVote
+	for _, vote := range votes {
+		if vote.Voter == voter {
+			filtered = append(filtered, vote)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,203 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type RequestAccountRecoveryOperation struct {
+	RecoveryAccount   string    `json:"recovery_account"`
+	AccountToRecover  string    `json:"account_to_recover"`
+	NewOwnerAuthority Authority `json:"new_owner_authority"`
+}
+
+func (o RequestAccountRecoveryOperation) opName() string {
+	return "request_account_recovery"
+}
+
+func (o RequestAccountRecoveryOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.RecoveryAccount}}
+}
+
+type RecoverAccountOperation struct {
+	AccountToRecover     string    `json:"account_to_recover"`
+	NewOwnerAuthority    Authority `json:"new_owner_authority"`
+	RecentOwnerAuthority Authority `json:"recent_owner_authority"`
+}
+
+func (o RecoverAccountOperation) opName() string {
+	return "recover_account"
+}
+
+// requiredAuths needs both the new owner authority and an owner authority the
+// account had recently, rather than any authority of a named account.
+func (o RecoverAccountOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Other: []types.Authority{
+		o.NewOwnerAuthority.toAccountAuthority(),
+		o.RecentOwnerAuthority.toAccountAuthority(),
+	}}
+}
+
+type ChangeRecoveryAccountOperation struct {
+	AccountToRecover   string `json:"account_to_recover"`
+	NewRecoveryAccount string `json:"new_recovery_account"`
+}
+
+func (o ChangeRecoveryAccountOperation) opName() string {
+	return "change_recovery_account"
+}
+
+func (o ChangeRecoveryAccountOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Owner: []string{o.AccountToRecover}}
+}
+
+// ResetAccountOperation is disabled on chain.
+type ResetAccountOperation struct {
+	ResetAccount      string    `json:"reset_account"`
+	AccountToReset    string    `json:"account_to_reset"`
+	NewOwnerAuthority Authority `json:"new_owner_authority"`
+}
+
+func (o ResetAccountOperation) opName() string {
+	return "reset_account"
+}
+
+func (o ResetAccountOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.ResetAccount}}
+}
+
+// SetResetAccountOperation is disabled on chain.
+type SetResetAccountOperation struct {
+	Account             string `json:"account"`
+	CurrentResetAccount string `json:"current_reset_account"`
+	ResetAccount        string `json:"reset_account"`
+}
+
+func (o SetResetAccountOperation) opName() string {
+	return "set_reset_account"
+}
+
+func (o SetResetAccountOperation) requiredAuths() RequiredAuthorities {
+	if o.CurrentResetAccount != "" {
+		return RequiredAuthorities{Owner: []string{o.Account}}
+	}
+	return RequiredAuthorities{Posting: []string{o.Account}}
+}
+
+// RequestAccountRecovery is sent by recoveryAccount, the recovery account of
+// accountToRecover, to let it recover with newOwner. An authority with a
+// threshold of zero cancels a pending request.
+//
+// Recovering a stolen account takes two steps:
+//
+//  1. The user creates new keys, for example with GenerateAccountKeys, and
+//     gives the new owner public key to their recovery account, which calls
+//     RequestAccountRecovery. The request is valid for one day.
+//  2. The user calls RecoverAccount with the new owner key and an owner key the
+//     account had within the last 30 days. Afterwards the active and posting
+//     keys, which the attacker may still hold, should be replaced with RotateKeys.
+func (h *HiveRpcNode) RequestAccountRecovery(recoveryAccount string, accountToRecover string, newOwner Authority, wif *string) (string, error) {
+	op := RequestAccountRecoveryOperation{
+		RecoveryAccount:   recoveryAccount,
+		AccountToRecover:  accountToRecover,
+		NewOwnerAuthority: newOwner,
+	}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// RecoverAccount completes a pending recovery request of accountToRecover. It
+// is signed with the new owner key from the request and with recentOwnerWif,
+// an owner key the account had within the last 30 days.
+func (h *HiveRpcNode) RecoverAccount(accountToRecover string, newOwnerWif *string, recentOwnerWif *string) (string, error) {
+	newOwnerKeyPair, err := KeyPairFromWif(*newOwnerWif)
+	if err != nil {
+		return "", err
+	}
+	recentOwnerKeyPair, err := KeyPairFromWif(*recentOwnerWif)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := h.GetRecoveryRequest(accountToRecover)
+	if err != nil {
+		return "", err
+	}
+	history, err := h.GetOwnerHistory(accountToRecover)
+	if err != nil {
+		return "", err
+	}
+
+	op, err := recoverAccountOp(request, history, *newOwnerKeyPair.GetPublicKeyString(), *recentOwnerKeyPair.GetPublicKeyString())
+	if err != nil {
+		return "", err
+	}
+
+	return h.broadcast([]HiveOperation{op}, newOwnerWif, recentOwnerWif)
+}
+
+// recoverAccountOp matches the new owner key to the pending request and the
+// recent owner key to a previous owner authority of the account.
+func recoverAccountOp(request types.AccountRecoveryRequest, history []types.OwnerAuthorityHistory, newOwnerKey string, recentOwnerKey string) (RecoverAccountOperation, error) {
+	if _, ok := request.NewOwnerAuthority.KeyWeights()[newOwnerKey]; !ok {
+		return RecoverAccountOperation{}, errors.New("key " + newOwnerKey + " is not in the requested owner authority of " + request.AccountToRecover)
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if _, ok := history[i].PreviousOwnerAuthority.KeyWeights()[recentOwnerKey]; ok {
+			return RecoverAccountOperation{
+				AccountToRecover:     request.AccountToRecover,
+				NewOwnerAuthority:    AuthorityFromAccount(request.NewOwnerAuthority),
+				RecentOwnerAuthority: AuthorityFromAccount(history[i].PreviousOwnerAuthority),
+			}, nil
+		}
+	}
+
+	return RecoverAccountOperation{}, errors.New("key " + recentOwnerKey + " is not in the recent owner history of " + request.AccountToRecover)
+}
+
+// ChangeRecoveryAccount sets the account that can recover accountToRecover.
+// The change takes effect after 30 days and needs the owner key.
+func (h *HiveRpcNode) ChangeRecoveryAccount(accountToRecover string, newRecoveryAccount string, ownerWif *string) (string, error) {
+	op := ChangeRecoveryAccountOperation{accountToRecover, newRecoveryAccount}
+
+	return h.broadcast([]HiveOperation{op}, ownerWif)
+}
+
+// GetRecoveryRequest returns the pending recovery request of account.
+func (h *HiveRpcNode) GetRecoveryRequest(account string) (types.AccountRecoveryRequest, error) {
+	var query = hrpcQuery{method: "condenser_api.get_recovery_request", params: []string{account}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return types.AccountRecoveryRequest{}, err
+	}
+
+	var request *types.AccountRecoveryRequest
+	err = json.Unmarshal(res, &request)
+	if err != nil {
+		return types.AccountRecoveryRequest{}, err
+	}
+	if request == nil {
+		return types.AccountRecoveryRequest{}, errors.New("no recovery request for " + account)
+	}
+	return *request, nil
+}
+
+// GetOwnerHistory returns the previous owner authorities of account that can
+// still be used to recover it.
+func (h *HiveRpcNode) GetOwnerHistory(account string) ([]types.OwnerAuthorityHistory, error) {
+	var query = hrpcQuery{method: "condenser_api.get_owner_history", params: []string{account}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []types.OwnerAuthorityHistory
+	err = json.Unmarshal(res, &history)
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -0,0 +1,49 @@
+package hivego
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestRecoverAccountOp(t *testing.T) {
+	var request types.AccountRecoveryRequest
+	err := json.Unmarshal([]byte(`{"account_to_recover":"alice",
+		"new_owner_authority":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_new",1]]}}`), &request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var history []types.OwnerAuthorityHistory
+	err = json.Unmarshal([]byte(`[
+		{"account":"alice","previous_owner_authority":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_old",1]]}},
+		{"account":"alice","previous_owner_authority":{"weight_threshold":1,"account_auths":[],"key_auths":[["STM_stolen",1]]}}
+	]`), &history)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := recoverAccountOp(request, history, "STM_new", "STM_old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.AccountToRecover != "alice" || op.NewOwnerAuthority.KeyAuths["STM_new"] != 1 || op.RecentOwnerAuthority.KeyAuths["STM_old"] != 1 {
+		t.Error("Unexpected recover_account operation", op)
+	}
+
+	resolver := newAuthorityResolver(nil)
+	if err = verifyAuthorities(resolver, op.requiredAuths(), []string{"STM_new", "STM_old"}); err != nil {
+		t.Error("Expected both owner keys to satisfy recover_account:", err)
+	}
+	if err = verifyAuthorities(resolver, op.requiredAuths(), []string{"STM_new"}); err == nil {
+		t.Error("Expected recover_account to need the recent owner key")
+	}
+
+	if _, err = recoverAccountOp(request, history, "STM_old", "STM_old"); err == nil {
+		t.Error("Expected an error for a key that is not in the request")
+	}
+	if _, err = recoverAccountOp(request, history, "STM_new", "STM_other"); err == nil {
+		t.Error("Expected an error for a key that is not in the owner history")
+	}
+}
@@ -0,0 +1,143 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// RecurrentTransferOperation creates, updates or, with a zero amount, removes
+// a recurrent transfer. Recurrence is in hours. PairId, when set, is sent in
+// the recurrent_transfer_pair_id extension and allows several recurrent
+// transfers between the same two accounts.
+type RecurrentTransferOperation struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Amount     string `json:"amount"`
+	Memo       string `json:"memo"`
+	Recurrence uint16 `json:"recurrence"`
+	Executions uint16 `json:"executions"`
+	PairId     *uint8 `json:"-"`
+}
+
+func (o RecurrentTransferOperation) opName() string {
+	return "recurrent_transfer"
+}
+
+func (o RecurrentTransferOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
+}
+
+type recurrentTransferPairId struct {
+	PairId uint8 `json:"pair_id"`
+}
+
+func (o RecurrentTransferOperation) MarshalJSON() ([]byte, error) {
+	type plainOp RecurrentTransferOperation
+	extensions := []interface{}{}
+	if o.PairId != nil {
+		extensions = append(extensions, []interface{}{0, recurrentTransferPairId{*o.PairId}})
+	}
+
+	return json.Marshal(struct {
+		plainOp
+		Extensions []interface{} `json:"extensions"`
+	}{plainOp(o), extensions})
+}
+
+func (o *RecurrentTransferOperation) UnmarshalJSON(data []byte) error {
+	type plainOp RecurrentTransferOperation
+	var raw struct {
+		plainOp
+		Extensions []json.RawMessage `json:"extensions"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*o = RecurrentTransferOperation(raw.plainOp)
+	for _, ext := range raw.Extensions {
+		value, err := extensionValue(ext)
+		if err != nil {
+			return err
+		}
+
+		var p recurrentTransferPairId
+		err = json.Unmarshal(value, &p)
+		if err != nil {
+			return err
+		}
+		o.PairId = &p.PairId
+	}
+
+	return nil
+}
+
+// RecurrentTransfer creates or updates the recurrent transfer from from to to,
+// sending amount every recurrence hours for the given number of executions.
+// pairId may be nil when there is only one recurrent transfer between the accounts.
+func (h *HiveRpcNode) RecurrentTransfer(from string, to string, amount string, memo string, recurrence uint16, executions uint16, pairId *uint8, wif *string) (string, error) {
+	op := RecurrentTransferOperation{from, to, amount, memo, recurrence, executions, pairId}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// CancelRecurrentTransfer removes a recurrent transfer by setting its amount to zero.
+// pairId may be nil when there is only one recurrent transfer between the accounts.
+func (h *HiveRpcNode) CancelRecurrentTransfer(from string, to string, pairId *uint8, wif *string) (string, error) {
+	transfers, err := h.FindRecurrentTransfers(from)
+	if err != nil {
+		return "", err
+	}
+
+	op, err := cancelRecurrentTransferOp(from, to, pairId, transfers)
+	if err != nil {
+		return "", err
+	}
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// cancelRecurrentTransferOp returns the zero amount operation that removes
+// the recurrent transfer from from to to among transfers. The operation
+// carries the pair id of the transfer found, as the chain looks it up by pair.
+func cancelRecurrentTransferOp(from string, to string, pairId *uint8, transfers []types.RecurrentTransfer) (RecurrentTransferOperation, error) {
+	var found *types.RecurrentTransfer
+	for i, transfer := range transfers {
+		if transfer.To != to || (pairId != nil && transfer.PairID != *pairId) {
+			continue
+		}
+		if found != nil {
+			return RecurrentTransferOperation{}, errors.New("several recurrent transfers from " + from + " to " + to + ", a pair id is required")
+		}
+		found = &transfers[i]
+	}
+	if found == nil {
+		return RecurrentTransferOperation{}, errors.New("no recurrent transfer from " + from + " to " + to)
+	}
+
+	symbol := found.Amount[strings.LastIndex(found.Amount, " ")+1:]
+	foundPairId := found.PairID
+	return RecurrentTransferOperation{from, to, "0.000 " + symbol, "", found.Recurrence, 2, &foundPairId}, nil
+}
+
+// FindRecurrentTransfers lists the active recurrent transfers sent by account.
+func (h *HiveRpcNode) FindRecurrentTransfers(account string) ([]types.RecurrentTransfer, error) {
+	var query = hrpcQuery{
+		method: "condenser_api.find_recurrent_transfers",
+		params: []string{account},
+	}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []types.RecurrentTransfer
+	err = json.Unmarshal(res, &transfers)
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
@@ -0,0 +1,42 @@
+package hivego
+
+import (
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestCancelRecurrentTransferOp(t *testing.T) {
+	transfers := []types.RecurrentTransfer{
+		{From: "alice", To: "bob", Amount: "1.000 HBD", Recurrence: 24, PairID: 3},
+		{From: "alice", To: "carol", Amount: "1.000 HIVE", Recurrence: 48, PairID: 0},
+		{From: "alice", To: "carol", Amount: "2.000 HIVE", Recurrence: 72, PairID: 1},
+	}
+
+	op, err := cancelRecurrentTransferOp("alice", "bob", nil, transfers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Amount != "0.000 HBD" || op.Recurrence != 24 || op.PairId == nil || *op.PairId != 3 {
+		t.Error("got", op)
+	}
+
+	_, err = cancelRecurrentTransferOp("alice", "carol", nil, transfers)
+	if err == nil {
+		t.Error("expected an error for several transfers without a pair id")
+	}
+
+	pairId := uint8(1)
+	op, err = cancelRecurrentTransferOp("alice", "carol", &pairId, transfers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Amount != "0.000 HIVE" || op.Recurrence != 72 || *op.PairId != 1 {
+		t.Error("got", op)
+	}
+
+	_, err = cancelRecurrentTransferOp("alice", "dave", nil, transfers)
+	if err == nil {
+		t.Error("expected an error for no matching transfer")
+	}
+}
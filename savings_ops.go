@@ -0,0 +1,90 @@
+package hivego
+
+import (
+	"encoding/json"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type TransferToSavingsOperation struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	Memo   string `json:"memo"`
+}
+
+func (o TransferToSavingsOperation) opName() string {
+	return "transfer_to_savings"
+}
+
+func (o TransferToSavingsOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
+}
+
+func (h *HiveRpcNode) TransferToSavings(from string, to string, amount string, memo string, wif *string) (string, error) {
+	op := TransferToSavingsOperation{from, to, amount, memo}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type TransferFromSavingsOperation struct {
+	From      string `json:"from"`
+	RequestId uint32 `json:"request_id"`
+	To        string `json:"to"`
+	Amount    string `json:"amount"`
+	Memo      string `json:"memo"`
+}
+
+func (o TransferFromSavingsOperation) opName() string {
+	return "transfer_from_savings"
+}
+
+func (o TransferFromSavingsOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
+}
+
+// TransferFromSavings starts a withdrawal from savings, which completes after
+// three days. The request id must be unique among the pending withdrawals of from.
+func (h *HiveRpcNode) TransferFromSavings(from string, requestId uint32, to string, amount string, memo string, wif *string) (string, error) {
+	op := TransferFromSavingsOperation{from, requestId, to, amount, memo}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type CancelTransferFromSavingsOperation struct {
+	From      string `json:"from"`
+	RequestId uint32 `json:"request_id"`
+}
+
+func (o CancelTransferFromSavingsOperation) opName() string {
+	return "cancel_transfer_from_savings"
+}
+
+func (o CancelTransferFromSavingsOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
+}
+
+func (h *HiveRpcNode) CancelTransferFromSavings(from string, requestId uint32, wif *string) (string, error) {
+	op := CancelTransferFromSavingsOperation{from, requestId}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// GetSavingsWithdrawFrom lists the pending savings withdrawals started by account.
+func (h *HiveRpcNode) GetSavingsWithdrawFrom(account string) ([]types.SavingsWithdraw, error) {
+	var query = hrpcQuery{
+		method: "condenser_api.get_savings_withdraw_from",
+		params: []string{account},
+	}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var withdraws []types.SavingsWithdraw
+	err = json.Unmarshal(res, &withdraws)
+	if err != nil {
+		return nil, err
+	}
+	return withdraws, nil
+}
@@ -3,9 +3,9 @@ package hivego
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -36,7 +36,7 @@ func expTimeB(expTime string) ([]byte, error) {
 	return buf, nil
 }
 
-func countOpsB(ops []hiveOperation) []byte {
+func countOpsB(ops []HiveOperation) []byte {
 	b := make([]byte, 5)
 	l := binary.PutUvarint(b, uint64(len(ops)))
 	return b[0:l]
@@ -63,16 +63,40 @@ func appendVStringArray(a []string, b *bytes.Buffer) *bytes.Buffer {
 	return b
 }
 
-func appendVAsset(asset string, b *bytes.Buffer) error {
-	parts := strings.Split(asset, " ")
-	if len(parts) != 2 {
-		return errors.New("invalid asset format: " + asset)
+func appendUint16(v uint16, b *bytes.Buffer) *bytes.Buffer {
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, v)
+	b.Write(buf)
+	return b
+}
+
+func appendUint32(v uint32, b *bytes.Buffer) *bytes.Buffer {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, v)
+	b.Write(buf)
+	return b
+}
+
+func appendBool(v bool, b *bytes.Buffer) *bytes.Buffer {
+	if v {
+		b.WriteByte(1)
+	} else {
+		b.WriteByte(0)
 	}
+	return b
+}
+
+func appendVarint(v uint64, b *bytes.Buffer) *bytes.Buffer {
+	vBuf := make([]byte, binary.MaxVarintLen64)
+	vLen := binary.PutUvarint(vBuf, v)
+	b.Write(vBuf[0:vLen])
+	return b
+}
 
-	amountStr, symbol := parts[0], parts[1]
-	precision := 3
-	if symbol == "VESTS" {
-		precision = 6
+func appendVAsset(asset string, b *bytes.Buffer) error {
+	amount, precision, symbol, err := parseAsset(asset)
+	if err != nil {
+		return err
 	}
 
 	// Convert to legacy symbol names for compatibility
@@ -83,30 +107,6 @@ func appendVAsset(asset string, b *bytes.Buffer) error {
 		symbol = "SBD"
 	}
 
-	// Handle decimal parsing without floating points
-	parts = strings.Split(amountStr, ".")
-	if len(parts) > 2 {
-		return errors.New("invalid amount format: " + amountStr)
-	}
-
-	// Pad or truncate decimal part to required precision
-	decimalPart := ""
-	if len(parts) > 1 {
-		decimalPart = parts[1]
-	}
-	if len(decimalPart) > precision {
-		decimalPart = decimalPart[:precision]
-	} else {
-		decimalPart += strings.Repeat("0", precision-len(decimalPart))
-	}
-
-	// Combine whole and decimal parts
-	fullNumber := parts[0] + decimalPart
-	amount, err := strconv.ParseInt(fullNumber, 10, 64)
-	if err != nil {
-		return err
-	}
-
 	// Write the amount as int64
 	err = binary.Write(b, binary.LittleEndian, amount)
 	if err != nil {
@@ -128,7 +128,112 @@ func appendVAsset(asset string, b *bytes.Buffer) error {
 	return nil
 }
 
-func serializeTx(tx hiveTransaction) ([]byte, error) {
+func appendTimePoint(t string, b *bytes.Buffer) error {
+	timeB, err := expTimeB(t)
+	if err != nil {
+		return err
+	}
+	b.Write(timeB)
+	return nil
+}
+
+func appendPrice(p Price, b *bytes.Buffer) error {
+	err := appendVAsset(p.Base, b)
+	if err != nil {
+		return err
+	}
+	return appendVAsset(p.Quote, b)
+}
+
+func appendPublicKey(pubKey string, b *bytes.Buffer) error {
+	keyB, err := publicKeyBytes(pubKey)
+	if err != nil {
+		return err
+	}
+	b.Write(keyB)
+	return nil
+}
+
+func appendInt64Set(ids []int64, b *bytes.Buffer) *bytes.Buffer {
+	appendVarint(uint64(len(ids)), b)
+	for _, id := range ids {
+		binary.Write(b, binary.LittleEndian, id)
+	}
+	return b
+}
+
+// appendAccountSet writes a flat_set of account names, which the chain keeps sorted.
+func appendAccountSet(accounts []string, b *bytes.Buffer) *bytes.Buffer {
+	accounts = uniqueSorted(accounts)
+	appendVarint(uint64(len(accounts)), b)
+	for _, account := range accounts {
+		appendVString(account, b)
+	}
+	return b
+}
+
+// appendFixedHex writes a hex encoded hash or signature of exactly size bytes.
+func appendFixedHex(s string, size int, b *bytes.Buffer) error {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	if len(data) != size {
+		return errors.New("expected " + strconv.Itoa(size) + " bytes, got " + s)
+	}
+	b.Write(data)
+	return nil
+}
+
+// appendHexBytes writes hex encoded data as a length prefixed byte vector.
+func appendHexBytes(s string, b *bytes.Buffer) error {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	appendVarint(uint64(len(data)), b)
+	b.Write(data)
+	return nil
+}
+
+func appendChainProperties(p ChainProperties, b *bytes.Buffer) error {
+	err := appendVAsset(p.AccountCreationFee, b)
+	if err != nil {
+		return err
+	}
+	appendUint32(p.MaximumBlockSize, b)
+	appendUint16(p.HbdInterestRate, b)
+	return nil
+}
+
+func appendAuthority(a Authority, b *bytes.Buffer) error {
+	keys, err := a.sortedKeys()
+	if err != nil {
+		return err
+	}
+
+	appendUint32(a.WeightThreshold, b)
+
+	accounts := a.sortedAccounts()
+	appendVarint(uint64(len(accounts)), b)
+	for _, account := range accounts {
+		appendVString(account, b)
+		appendUint16(a.AccountAuths[account], b)
+	}
+
+	appendVarint(uint64(len(keys)), b)
+	for _, key := range keys {
+		err = appendPublicKey(key, b)
+		if err != nil {
+			return err
+		}
+		appendUint16(a.KeyAuths[key], b)
+	}
+
+	return nil
+}
+
+func serializeTx(tx HiveTransaction) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.Write(refBlockNumB(tx.RefBlockNum))
 	buf.Write(refBlockPrefixB(tx.RefBlockPrefix))
@@ -147,7 +252,7 @@ func serializeTx(tx hiveTransaction) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func serializeOps(ops []hiveOperation) ([]byte, error) {
+func serializeOps(ops []HiveOperation) ([]byte, error) {
 	var opsBuf bytes.Buffer
 	opsBuf.Write(countOpsB(ops))
 	for _, op := range ops {
@@ -160,9 +265,9 @@ func serializeOps(ops []hiveOperation) ([]byte, error) {
 	return opsBuf.Bytes(), nil
 }
 
-func (o voteOperation) serializeOp() ([]byte, error) {
+func (o VoteOperation) serializeOp() ([]byte, error) {
 	var voteBuf bytes.Buffer
-	voteBuf.Write([]byte{opIdB(o.opText)})
+	voteBuf.Write([]byte{opIdB(o.opName())})
 	appendVString(o.Voter, &voteBuf)
 	appendVString(o.Author, &voteBuf)
 	appendVString(o.Permlink, &voteBuf)
@@ -174,9 +279,9 @@ func (o voteOperation) serializeOp() ([]byte, error) {
 	return voteBuf.Bytes(), nil
 }
 
-func (o customJsonOperation) serializeOp() ([]byte, error) {
+func (o CustomJsonOperation) serializeOp() ([]byte, error) {
 	var jBuf bytes.Buffer
-	jBuf.Write([]byte{opIdB(o.opText)})
+	jBuf.Write([]byte{opIdB(o.opName())})
 	appendVStringArray(o.RequiredAuths, &jBuf)
 	appendVStringArray(o.RequiredPostingAuths, &jBuf)
 	appendVString(o.Id, &jBuf)
@@ -185,9 +290,9 @@ func (o customJsonOperation) serializeOp() ([]byte, error) {
 	return jBuf.Bytes(), nil
 }
 
-func (o claimRewardOperation) serializeOp() ([]byte, error) {
+func (o ClaimRewardOperation) serializeOp() ([]byte, error) {
 	var claimBuf bytes.Buffer
-	claimBuf.Write([]byte{opIdB(o.opText)})
+	claimBuf.Write([]byte{opIdB(o.opName())})
 	appendVString(o.Account, &claimBuf)
 	err := appendVAsset(o.RewardHIVE, &claimBuf)
 
@@ -210,9 +315,9 @@ func (o claimRewardOperation) serializeOp() ([]byte, error) {
 	return claimBuf.Bytes(), nil
 }
 
-func (o transferOperation) serializeOp() ([]byte, error) {
+func (o TransferOperation) serializeOp() ([]byte, error) {
 	var transferBuf bytes.Buffer
-	transferBuf.Write([]byte{opIdB(o.opText)})
+	transferBuf.Write([]byte{opIdB(o.opName())})
 	appendVString(o.From, &transferBuf)
 	appendVString(o.To, &transferBuf)
 	appendVAsset(o.Amount, &transferBuf)
@@ -220,3 +325,909 @@ func (o transferOperation) serializeOp() ([]byte, error) {
 
 	return transferBuf.Bytes(), nil
 }
+
+func (o CommentOperation) serializeOp() ([]byte, error) {
+	var commentBuf bytes.Buffer
+	commentBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.ParentAuthor, &commentBuf)
+	appendVString(o.ParentPermlink, &commentBuf)
+	appendVString(o.Author, &commentBuf)
+	appendVString(o.Permlink, &commentBuf)
+	appendVString(o.Title, &commentBuf)
+	appendVString(o.Body, &commentBuf)
+	appendVString(o.JsonMetadata, &commentBuf)
+
+	return commentBuf.Bytes(), nil
+}
+
+func (o CommentOptionsOperation) serializeOp() ([]byte, error) {
+	if o.PercentHbd > hive100Percent {
+		return nil, errors.New("percent_hbd cannot exceed 100%")
+	}
+
+	beneficiaries, err := o.sortedBeneficiaries()
+	if err != nil {
+		return nil, err
+	}
+
+	var optionsBuf bytes.Buffer
+	optionsBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Author, &optionsBuf)
+	appendVString(o.Permlink, &optionsBuf)
+	err = appendVAsset(o.MaxAcceptedPayout, &optionsBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendUint16(o.PercentHbd, &optionsBuf)
+	appendBool(o.AllowVotes, &optionsBuf)
+	appendBool(o.AllowCurationRewards, &optionsBuf)
+
+	if beneficiaries == nil {
+		optionsBuf.WriteByte(extensionsB())
+		return optionsBuf.Bytes(), nil
+	}
+
+	// one extension: comment_payout_beneficiaries, static_variant tag 0
+	appendVarint(1, &optionsBuf)
+	appendVarint(0, &optionsBuf)
+	appendVarint(uint64(len(beneficiaries)), &optionsBuf)
+	for _, b := range beneficiaries {
+		appendVString(b.Account, &optionsBuf)
+		appendUint16(b.Weight, &optionsBuf)
+	}
+
+	return optionsBuf.Bytes(), nil
+}
+
+func (o DeleteCommentOperation) serializeOp() ([]byte, error) {
+	var deleteBuf bytes.Buffer
+	deleteBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Author, &deleteBuf)
+	appendVString(o.Permlink, &deleteBuf)
+
+	return deleteBuf.Bytes(), nil
+}
+
+func (o TransferToVestingOperation) serializeOp() ([]byte, error) {
+	err := checkAssetSymbol(o.Amount, "HIVE")
+	if err != nil {
+		return nil, err
+	}
+
+	var vestBuf bytes.Buffer
+	vestBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &vestBuf)
+	appendVString(o.To, &vestBuf)
+	err = appendVAsset(o.Amount, &vestBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return vestBuf.Bytes(), nil
+}
+
+func (o WithdrawVestingOperation) serializeOp() ([]byte, error) {
+	err := checkAssetSymbol(o.VestingShares, "VESTS")
+	if err != nil {
+		return nil, err
+	}
+
+	var withdrawBuf bytes.Buffer
+	withdrawBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &withdrawBuf)
+	err = appendVAsset(o.VestingShares, &withdrawBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return withdrawBuf.Bytes(), nil
+}
+
+func (o SetWithdrawVestingRouteOperation) serializeOp() ([]byte, error) {
+	if o.Percent > hive100Percent {
+		return nil, errors.New("percent cannot exceed 100%")
+	}
+
+	var routeBuf bytes.Buffer
+	routeBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.FromAccount, &routeBuf)
+	appendVString(o.ToAccount, &routeBuf)
+	appendUint16(o.Percent, &routeBuf)
+	appendBool(o.AutoVest, &routeBuf)
+
+	return routeBuf.Bytes(), nil
+}
+
+func (o DelegateVestingSharesOperation) serializeOp() ([]byte, error) {
+	err := checkAssetSymbol(o.VestingShares, "VESTS")
+	if err != nil {
+		return nil, err
+	}
+
+	var delegateBuf bytes.Buffer
+	delegateBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Delegator, &delegateBuf)
+	appendVString(o.Delegatee, &delegateBuf)
+	err = appendVAsset(o.VestingShares, &delegateBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return delegateBuf.Bytes(), nil
+}
+
+func (o TransferToSavingsOperation) serializeOp() ([]byte, error) {
+	var savingsBuf bytes.Buffer
+	savingsBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &savingsBuf)
+	appendVString(o.To, &savingsBuf)
+	err := appendVAsset(o.Amount, &savingsBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Memo, &savingsBuf)
+
+	return savingsBuf.Bytes(), nil
+}
+
+func (o TransferFromSavingsOperation) serializeOp() ([]byte, error) {
+	var savingsBuf bytes.Buffer
+	savingsBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &savingsBuf)
+	appendUint32(o.RequestId, &savingsBuf)
+	appendVString(o.To, &savingsBuf)
+	err := appendVAsset(o.Amount, &savingsBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Memo, &savingsBuf)
+
+	return savingsBuf.Bytes(), nil
+}
+
+func (o CancelTransferFromSavingsOperation) serializeOp() ([]byte, error) {
+	var cancelBuf bytes.Buffer
+	cancelBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &cancelBuf)
+	appendUint32(o.RequestId, &cancelBuf)
+
+	return cancelBuf.Bytes(), nil
+}
+
+func (o RecurrentTransferOperation) serializeOp() ([]byte, error) {
+	var recurrentBuf bytes.Buffer
+	recurrentBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &recurrentBuf)
+	appendVString(o.To, &recurrentBuf)
+	err := appendVAsset(o.Amount, &recurrentBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Memo, &recurrentBuf)
+	appendUint16(o.Recurrence, &recurrentBuf)
+	appendUint16(o.Executions, &recurrentBuf)
+
+	if o.PairId == nil {
+		recurrentBuf.WriteByte(extensionsB())
+		return recurrentBuf.Bytes(), nil
+	}
+
+	// one extension: recurrent_transfer_pair_id, static_variant tag 0
+	appendVarint(1, &recurrentBuf)
+	appendVarint(0, &recurrentBuf)
+	recurrentBuf.WriteByte(*o.PairId)
+
+	return recurrentBuf.Bytes(), nil
+}
+
+func (o LimitOrderCreateOperation) serializeOp() ([]byte, error) {
+	var orderBuf bytes.Buffer
+	orderBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &orderBuf)
+	appendUint32(o.OrderId, &orderBuf)
+	err := appendVAsset(o.AmountToSell, &orderBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendVAsset(o.MinToReceive, &orderBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendBool(o.FillOrKill, &orderBuf)
+	err = appendTimePoint(o.Expiration, &orderBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderBuf.Bytes(), nil
+}
+
+func (o LimitOrderCreate2Operation) serializeOp() ([]byte, error) {
+	var orderBuf bytes.Buffer
+	orderBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &orderBuf)
+	appendUint32(o.OrderId, &orderBuf)
+	err := appendVAsset(o.AmountToSell, &orderBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendBool(o.FillOrKill, &orderBuf)
+	err = appendPrice(o.ExchangeRate, &orderBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendTimePoint(o.Expiration, &orderBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderBuf.Bytes(), nil
+}
+
+func (o LimitOrderCancelOperation) serializeOp() ([]byte, error) {
+	var cancelBuf bytes.Buffer
+	cancelBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &cancelBuf)
+	appendUint32(o.OrderId, &cancelBuf)
+
+	return cancelBuf.Bytes(), nil
+}
+
+func (o ConvertOperation) serializeOp() ([]byte, error) {
+	var convertBuf bytes.Buffer
+	convertBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &convertBuf)
+	appendUint32(o.RequestId, &convertBuf)
+	err := appendVAsset(o.Amount, &convertBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBuf.Bytes(), nil
+}
+
+func (o CollateralizedConvertOperation) serializeOp() ([]byte, error) {
+	var convertBuf bytes.Buffer
+	convertBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &convertBuf)
+	appendUint32(o.RequestId, &convertBuf)
+	err := appendVAsset(o.Amount, &convertBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBuf.Bytes(), nil
+}
+
+func (o EscrowTransferOperation) serializeOp() ([]byte, error) {
+	var escrowBuf bytes.Buffer
+	escrowBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &escrowBuf)
+	appendVString(o.To, &escrowBuf)
+	err := appendVAsset(o.HbdAmount, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendVAsset(o.HiveAmount, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendUint32(o.EscrowId, &escrowBuf)
+	appendVString(o.Agent, &escrowBuf)
+	err = appendVAsset(o.Fee, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.JsonMeta, &escrowBuf)
+	err = appendTimePoint(o.RatificationDeadline, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendTimePoint(o.EscrowExpiration, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return escrowBuf.Bytes(), nil
+}
+
+func (o EscrowApproveOperation) serializeOp() ([]byte, error) {
+	var escrowBuf bytes.Buffer
+	escrowBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &escrowBuf)
+	appendVString(o.To, &escrowBuf)
+	appendVString(o.Agent, &escrowBuf)
+	appendVString(o.Who, &escrowBuf)
+	appendUint32(o.EscrowId, &escrowBuf)
+	appendBool(o.Approve, &escrowBuf)
+
+	return escrowBuf.Bytes(), nil
+}
+
+func (o EscrowDisputeOperation) serializeOp() ([]byte, error) {
+	var escrowBuf bytes.Buffer
+	escrowBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &escrowBuf)
+	appendVString(o.To, &escrowBuf)
+	appendVString(o.Agent, &escrowBuf)
+	appendVString(o.Who, &escrowBuf)
+	appendUint32(o.EscrowId, &escrowBuf)
+
+	return escrowBuf.Bytes(), nil
+}
+
+func (o EscrowReleaseOperation) serializeOp() ([]byte, error) {
+	var escrowBuf bytes.Buffer
+	escrowBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.From, &escrowBuf)
+	appendVString(o.To, &escrowBuf)
+	appendVString(o.Agent, &escrowBuf)
+	appendVString(o.Who, &escrowBuf)
+	appendVString(o.Receiver, &escrowBuf)
+	appendUint32(o.EscrowId, &escrowBuf)
+	err := appendVAsset(o.HbdAmount, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendVAsset(o.HiveAmount, &escrowBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return escrowBuf.Bytes(), nil
+}
+
+func (o AccountWitnessVoteOperation) serializeOp() ([]byte, error) {
+	var voteBuf bytes.Buffer
+	voteBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &voteBuf)
+	appendVString(o.Witness, &voteBuf)
+	appendBool(o.Approve, &voteBuf)
+
+	return voteBuf.Bytes(), nil
+}
+
+func (o AccountWitnessProxyOperation) serializeOp() ([]byte, error) {
+	var proxyBuf bytes.Buffer
+	proxyBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &proxyBuf)
+	appendVString(o.Proxy, &proxyBuf)
+
+	return proxyBuf.Bytes(), nil
+}
+
+func (o WitnessUpdateOperation) serializeOp() ([]byte, error) {
+	var witnessBuf bytes.Buffer
+	witnessBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &witnessBuf)
+	appendVString(o.Url, &witnessBuf)
+	err := appendPublicKey(o.BlockSigningKey, &witnessBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendChainProperties(o.Props, &witnessBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendVAsset(o.Fee, &witnessBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return witnessBuf.Bytes(), nil
+}
+
+func (o WitnessSetPropertiesOperation) serializeOp() ([]byte, error) {
+	props, err := o.Props.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	var propsBuf bytes.Buffer
+	propsBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Owner, &propsBuf)
+	appendVarint(uint64(len(props)), &propsBuf)
+	for _, name := range sortedPropNames(props) {
+		appendVString(name, &propsBuf)
+		appendVarint(uint64(len(props[name])), &propsBuf)
+		propsBuf.Write(props[name])
+	}
+	propsBuf.WriteByte(extensionsB())
+
+	return propsBuf.Bytes(), nil
+}
+
+func (o FeedPublishOperation) serializeOp() ([]byte, error) {
+	var feedBuf bytes.Buffer
+	feedBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Publisher, &feedBuf)
+	err := appendPrice(o.ExchangeRate, &feedBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return feedBuf.Bytes(), nil
+}
+
+func (o CreateProposalOperation) serializeOp() ([]byte, error) {
+	var proposalBuf bytes.Buffer
+	proposalBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Creator, &proposalBuf)
+	appendVString(o.Receiver, &proposalBuf)
+	err := appendTimePoint(o.StartDate, &proposalBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendTimePoint(o.EndDate, &proposalBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendVAsset(o.DailyPay, &proposalBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Subject, &proposalBuf)
+	appendVString(o.Permlink, &proposalBuf)
+	proposalBuf.WriteByte(extensionsB())
+
+	return proposalBuf.Bytes(), nil
+}
+
+func (o UpdateProposalVotesOperation) serializeOp() ([]byte, error) {
+	ids, err := sortedProposalIds(o.ProposalIds, maxProposalIdsPerVote)
+	if err != nil {
+		return nil, err
+	}
+
+	var votesBuf bytes.Buffer
+	votesBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Voter, &votesBuf)
+	appendInt64Set(ids, &votesBuf)
+	appendBool(o.Approve, &votesBuf)
+	votesBuf.WriteByte(extensionsB())
+
+	return votesBuf.Bytes(), nil
+}
+
+func (o RemoveProposalOperation) serializeOp() ([]byte, error) {
+	ids, err := sortedProposalIds(o.ProposalIds, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var removeBuf bytes.Buffer
+	removeBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.ProposalOwner, &removeBuf)
+	appendInt64Set(ids, &removeBuf)
+	removeBuf.WriteByte(extensionsB())
+
+	return removeBuf.Bytes(), nil
+}
+
+func (o UpdateProposalOperation) serializeOp() ([]byte, error) {
+	var updateBuf bytes.Buffer
+	updateBuf.Write([]byte{opIdB(o.opName())})
+	binary.Write(&updateBuf, binary.LittleEndian, o.ProposalId)
+	appendVString(o.Creator, &updateBuf)
+	err := appendVAsset(o.DailyPay, &updateBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Subject, &updateBuf)
+	appendVString(o.Permlink, &updateBuf)
+
+	if o.EndDate == nil {
+		updateBuf.WriteByte(extensionsB())
+		return updateBuf.Bytes(), nil
+	}
+
+	// one extension: update_proposal_end_date, static_variant tag 1
+	appendVarint(1, &updateBuf)
+	appendVarint(1, &updateBuf)
+	err = appendTimePoint(*o.EndDate, &updateBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateBuf.Bytes(), nil
+}
+
+func (o ClaimAccountOperation) serializeOp() ([]byte, error) {
+	var claimBuf bytes.Buffer
+	claimBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Creator, &claimBuf)
+	err := appendVAsset(o.Fee, &claimBuf)
+	if err != nil {
+		return nil, err
+	}
+	claimBuf.WriteByte(extensionsB())
+
+	return claimBuf.Bytes(), nil
+}
+
+func appendNewAccount(owner Authority, active Authority, posting Authority, memoKey string, jsonMetadata string, b *bytes.Buffer) error {
+	for _, auth := range []Authority{owner, active, posting} {
+		err := appendAuthority(auth, b)
+		if err != nil {
+			return err
+		}
+	}
+	err := appendPublicKey(memoKey, b)
+	if err != nil {
+		return err
+	}
+	appendVString(jsonMetadata, b)
+	return nil
+}
+
+func (o CreateClaimedAccountOperation) serializeOp() ([]byte, error) {
+	var createBuf bytes.Buffer
+	createBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Creator, &createBuf)
+	appendVString(o.NewAccountName, &createBuf)
+	err := appendNewAccount(o.Owner, o.Active, o.Posting, o.MemoKey, o.JsonMetadata, &createBuf)
+	if err != nil {
+		return nil, err
+	}
+	createBuf.WriteByte(extensionsB())
+
+	return createBuf.Bytes(), nil
+}
+
+func (o AccountCreateOperation) serializeOp() ([]byte, error) {
+	var createBuf bytes.Buffer
+	createBuf.Write([]byte{opIdB(o.opName())})
+	err := appendVAsset(o.Fee, &createBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Creator, &createBuf)
+	appendVString(o.NewAccountName, &createBuf)
+	err = appendNewAccount(o.Owner, o.Active, o.Posting, o.MemoKey, o.JsonMetadata, &createBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return createBuf.Bytes(), nil
+}
+
+func (o AccountCreateWithDelegationOperation) serializeOp() ([]byte, error) {
+	var createBuf bytes.Buffer
+	createBuf.Write([]byte{opIdB(o.opName())})
+	err := appendVAsset(o.Fee, &createBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendVAsset(o.Delegation, &createBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.Creator, &createBuf)
+	appendVString(o.NewAccountName, &createBuf)
+	err = appendNewAccount(o.Owner, o.Active, o.Posting, o.MemoKey, o.JsonMetadata, &createBuf)
+	if err != nil {
+		return nil, err
+	}
+	createBuf.WriteByte(extensionsB())
+
+	return createBuf.Bytes(), nil
+}
+
+func appendOptionalAuthority(a *Authority, b *bytes.Buffer) error {
+	if a == nil {
+		appendBool(false, b)
+		return nil
+	}
+	appendBool(true, b)
+	return appendAuthority(*a, b)
+}
+
+func (o AccountUpdateOperation) serializeOp() ([]byte, error) {
+	var updateBuf bytes.Buffer
+	updateBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &updateBuf)
+	for _, auth := range []*Authority{o.Owner, o.Active, o.Posting} {
+		err := appendOptionalAuthority(auth, &updateBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := appendPublicKey(o.MemoKey, &updateBuf)
+	if err != nil {
+		return nil, err
+	}
+	appendVString(o.JsonMetadata, &updateBuf)
+
+	return updateBuf.Bytes(), nil
+}
+
+func (o AccountUpdate2Operation) serializeOp() ([]byte, error) {
+	var updateBuf bytes.Buffer
+	updateBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &updateBuf)
+	for _, auth := range []*Authority{o.Owner, o.Active, o.Posting} {
+		err := appendOptionalAuthority(auth, &updateBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if o.MemoKey == nil {
+		appendBool(false, &updateBuf)
+	} else {
+		appendBool(true, &updateBuf)
+		err := appendPublicKey(*o.MemoKey, &updateBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	appendVString(o.JsonMetadata, &updateBuf)
+	appendVString(o.PostingJsonMetadata, &updateBuf)
+	updateBuf.WriteByte(extensionsB())
+
+	return updateBuf.Bytes(), nil
+}
+
+func (o RequestAccountRecoveryOperation) serializeOp() ([]byte, error) {
+	var requestBuf bytes.Buffer
+	requestBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.RecoveryAccount, &requestBuf)
+	appendVString(o.AccountToRecover, &requestBuf)
+	err := appendAuthority(o.NewOwnerAuthority, &requestBuf)
+	if err != nil {
+		return nil, err
+	}
+	requestBuf.WriteByte(extensionsB())
+
+	return requestBuf.Bytes(), nil
+}
+
+func (o RecoverAccountOperation) serializeOp() ([]byte, error) {
+	var recoverBuf bytes.Buffer
+	recoverBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.AccountToRecover, &recoverBuf)
+	err := appendAuthority(o.NewOwnerAuthority, &recoverBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendAuthority(o.RecentOwnerAuthority, &recoverBuf)
+	if err != nil {
+		return nil, err
+	}
+	recoverBuf.WriteByte(extensionsB())
+
+	return recoverBuf.Bytes(), nil
+}
+
+func (o ChangeRecoveryAccountOperation) serializeOp() ([]byte, error) {
+	var changeBuf bytes.Buffer
+	changeBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.AccountToRecover, &changeBuf)
+	appendVString(o.NewRecoveryAccount, &changeBuf)
+	changeBuf.WriteByte(extensionsB())
+
+	return changeBuf.Bytes(), nil
+}
+
+func (o DeclineVotingRightsOperation) serializeOp() ([]byte, error) {
+	var declineBuf bytes.Buffer
+	declineBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &declineBuf)
+	appendBool(o.Decline, &declineBuf)
+
+	return declineBuf.Bytes(), nil
+}
+
+func (o ResetAccountOperation) serializeOp() ([]byte, error) {
+	var resetBuf bytes.Buffer
+	resetBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.ResetAccount, &resetBuf)
+	appendVString(o.AccountToReset, &resetBuf)
+	err := appendAuthority(o.NewOwnerAuthority, &resetBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return resetBuf.Bytes(), nil
+}
+
+func (o SetResetAccountOperation) serializeOp() ([]byte, error) {
+	var setBuf bytes.Buffer
+	setBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Account, &setBuf)
+	appendVString(o.CurrentResetAccount, &setBuf)
+	appendVString(o.ResetAccount, &setBuf)
+
+	return setBuf.Bytes(), nil
+}
+
+func (o CustomOperation) serializeOp() ([]byte, error) {
+	var customBuf bytes.Buffer
+	customBuf.Write([]byte{opIdB(o.opName())})
+	appendAccountSet(o.RequiredAuths, &customBuf)
+	appendUint16(o.Id, &customBuf)
+	err := appendHexBytes(o.Data, &customBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return customBuf.Bytes(), nil
+}
+
+func (o CustomBinaryOperation) serializeOp() ([]byte, error) {
+	var customBuf bytes.Buffer
+	customBuf.Write([]byte{opIdB(o.opName())})
+	appendAccountSet(o.RequiredOwnerAuths, &customBuf)
+	appendAccountSet(o.RequiredActiveAuths, &customBuf)
+	appendAccountSet(o.RequiredPostingAuths, &customBuf)
+	appendVarint(uint64(len(o.RequiredAuths)), &customBuf)
+	for _, auth := range o.RequiredAuths {
+		err := appendAuthority(auth, &customBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	appendVString(o.Id, &customBuf)
+	err := appendHexBytes(o.Data, &customBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return customBuf.Bytes(), nil
+}
+
+func (o PowOperation) serializeOp() ([]byte, error) {
+	var powBuf bytes.Buffer
+	powBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.WorkerAccount, &powBuf)
+	err := appendFixedHex(o.BlockId, 20, &powBuf)
+	if err != nil {
+		return nil, err
+	}
+	binary.Write(&powBuf, binary.LittleEndian, o.Nonce)
+
+	err = appendPublicKey(o.Work.Worker, &powBuf)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range []struct {
+		hex  string
+		size int
+	}{{o.Work.Input, 32}, {o.Work.Signature, 65}, {o.Work.Work, 32}} {
+		err = appendFixedHex(field.hex, field.size, &powBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = appendChainProperties(o.Props, &powBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return powBuf.Bytes(), nil
+}
+
+func appendPow2Input(i Pow2Input, b *bytes.Buffer) error {
+	appendVString(i.WorkerAccount, b)
+	err := appendFixedHex(i.PrevBlock, 20, b)
+	if err != nil {
+		return err
+	}
+	return binary.Write(b, binary.LittleEndian, i.Nonce)
+}
+
+func (o Pow2Operation) serializeOp() ([]byte, error) {
+	var powBuf bytes.Buffer
+	powBuf.Write([]byte{opIdB(o.opName())})
+
+	switch {
+	case o.Work.Pow2 != nil:
+		appendVarint(0, &powBuf)
+		err := appendPow2Input(o.Work.Pow2.Input, &powBuf)
+		if err != nil {
+			return nil, err
+		}
+		appendUint32(o.Work.Pow2.PowSummary, &powBuf)
+	case o.Work.EquihashPow != nil:
+		work := o.Work.EquihashPow
+		appendVarint(1, &powBuf)
+		err := appendPow2Input(work.Input, &powBuf)
+		if err != nil {
+			return nil, err
+		}
+		appendUint32(work.Proof.N, &powBuf)
+		appendUint32(work.Proof.K, &powBuf)
+		err = appendFixedHex(work.Proof.Seed, 32, &powBuf)
+		if err != nil {
+			return nil, err
+		}
+		appendVarint(uint64(len(work.Proof.Inputs)), &powBuf)
+		for _, input := range work.Proof.Inputs {
+			appendUint32(input, &powBuf)
+		}
+		err = appendFixedHex(work.PrevBlock, 20, &powBuf)
+		if err != nil {
+			return nil, err
+		}
+		appendUint32(work.PowSummary, &powBuf)
+	default:
+		return nil, errors.New("pow2 work not set")
+	}
+
+	if o.NewOwnerKey == nil {
+		appendBool(false, &powBuf)
+	} else {
+		appendBool(true, &powBuf)
+		err := appendPublicKey(*o.NewOwnerKey, &powBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := appendChainProperties(o.Props, &powBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return powBuf.Bytes(), nil
+}
+
+func appendSignedBlockHeader(h SignedBlockHeader, b *bytes.Buffer) error {
+	err := appendFixedHex(h.Previous, 20, b)
+	if err != nil {
+		return err
+	}
+	err = appendTimePoint(h.Timestamp, b)
+	if err != nil {
+		return err
+	}
+	appendVString(h.Witness, b)
+	err = appendFixedHex(h.TransactionMerkleRoot, 20, b)
+	if err != nil {
+		return err
+	}
+
+	appendVarint(uint64(len(h.Extensions)), b)
+	for _, ext := range h.Extensions {
+		switch {
+		case ext.Version != nil:
+			version, err := parseVersion(*ext.Version)
+			if err != nil {
+				return err
+			}
+			appendVarint(1, b)
+			appendUint32(version, b)
+		case ext.HardforkVersionVote != nil:
+			version, err := parseVersion(ext.HardforkVersionVote.HfVersion)
+			if err != nil {
+				return err
+			}
+			appendVarint(2, b)
+			appendUint32(version, b)
+			err = appendTimePoint(ext.HardforkVersionVote.HfTime, b)
+			if err != nil {
+				return err
+			}
+		default:
+			appendVarint(0, b)
+		}
+	}
+
+	return appendFixedHex(h.WitnessSignature, 65, b)
+}
+
+func (o ReportOverProductionOperation) serializeOp() ([]byte, error) {
+	var reportBuf bytes.Buffer
+	reportBuf.Write([]byte{opIdB(o.opName())})
+	appendVString(o.Reporter, &reportBuf)
+	err := appendSignedBlockHeader(o.FirstBlock, &reportBuf)
+	if err != nil {
+		return nil, err
+	}
+	err = appendSignedBlockHeader(o.SecondBlock, &reportBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	return reportBuf.Bytes(), nil
+}
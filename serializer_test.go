@@ -101,3 +101,223 @@ func TestSerializeOpCustomJsonOperation(t *testing.T) {
 		t.Error("Expected", expected, "got", got)
 	}
 }
+
+func TestSerializeOpCommentOptionsOperation(t *testing.T) {
+	op := CommentOptionsOperation{
+		Author:               "a",
+		Permlink:             "p",
+		MaxAcceptedPayout:    "1000000.000 HBD",
+		PercentHbd:           10000,
+		AllowVotes:           true,
+		AllowCurationRewards: true,
+		Beneficiaries:        []Beneficiary{{"c", 200}, {"b", 100}},
+	}
+	got, err := op.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{19, 1, 97, 1, 112, 0, 202, 154, 59, 0, 0, 0, 0, 3, 83, 66, 68, 0, 0, 0, 0, 16, 39, 1, 1, 1, 0, 2, 1, 98, 100, 0, 1, 99, 200, 0}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpCommentOptionsRejectsDuplicateBeneficiaries(t *testing.T) {
+	op := CommentOptionsOperation{MaxAcceptedPayout: "0.000 HBD", Beneficiaries: []Beneficiary{{"b", 100}, {"b", 100}}}
+	if _, err := op.serializeOp(); err == nil {
+		t.Error("Expected an error for duplicate beneficiaries")
+	}
+}
+
+func TestSerializeOpWithdrawVestingOperation(t *testing.T) {
+	got, err := WithdrawVestingOperation{Account: "a", VestingShares: "1.000000 VESTS"}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{4, 1, 97, 64, 66, 15, 0, 0, 0, 0, 0, 6, 86, 69, 83, 84, 83, 0, 0}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+
+	if _, err = (WithdrawVestingOperation{Account: "a", VestingShares: "1.000 HIVE"}).serializeOp(); err == nil {
+		t.Error("Expected an error for a non VESTS amount")
+	}
+}
+
+func TestSerializeOpTransferFromSavingsOperation(t *testing.T) {
+	got, err := TransferFromSavingsOperation{From: "a", RequestId: 258, To: "b", Amount: "0.001 HBD", Memo: ""}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{33, 1, 97, 2, 1, 0, 0, 1, 98, 1, 0, 0, 0, 0, 0, 0, 0, 3, 83, 66, 68, 0, 0, 0, 0, 0}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpRecurrentTransferOperation(t *testing.T) {
+	pairId := uint8(7)
+	got, err := RecurrentTransferOperation{From: "a", To: "b", Amount: "0.001 HIVE", Memo: "m", Recurrence: 24, Executions: 2, PairId: &pairId}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{49, 1, 97, 1, 98, 1, 0, 0, 0, 0, 0, 0, 0, 3, 83, 84, 69, 69, 77, 0, 0, 1, 109, 24, 0, 2, 0, 1, 0, 7}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpLimitOrderCreate2Operation(t *testing.T) {
+	op := LimitOrderCreate2Operation{
+		Owner:        "a",
+		OrderId:      1,
+		AmountToSell: "1.000 HIVE",
+		FillOrKill:   false,
+		ExchangeRate: Price{Base: "1.000 HIVE", Quote: "0.250 HBD"},
+		Expiration:   "2016-08-08T12:24:17",
+	}
+	got, err := op.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{21, 1, 97, 1, 0, 0, 0,
+		232, 3, 0, 0, 0, 0, 0, 0, 3, 83, 84, 69, 69, 77, 0, 0,
+		0,
+		232, 3, 0, 0, 0, 0, 0, 0, 3, 83, 84, 69, 69, 77, 0, 0,
+		250, 0, 0, 0, 0, 0, 0, 0, 3, 83, 66, 68, 0, 0, 0, 0,
+		241, 121, 168, 87}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpEscrowTransferOperation(t *testing.T) {
+	op := EscrowTransferOperation{
+		From:                 "a",
+		To:                   "b",
+		Agent:                "c",
+		EscrowId:             1,
+		HbdAmount:            "0.001 HBD",
+		HiveAmount:           "0.000 HIVE",
+		Fee:                  "0.001 HIVE",
+		RatificationDeadline: "2016-08-08T12:24:17",
+		EscrowExpiration:     "2016-08-08T12:24:17",
+		JsonMeta:             "",
+	}
+	got, err := op.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{27, 1, 97, 1, 98,
+		1, 0, 0, 0, 0, 0, 0, 0, 3, 83, 66, 68, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 3, 83, 84, 69, 69, 77, 0, 0,
+		1, 0, 0, 0, 1, 99,
+		1, 0, 0, 0, 0, 0, 0, 0, 3, 83, 84, 69, 69, 77, 0, 0,
+		0, 241, 121, 168, 87, 241, 121, 168, 87}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpUpdateProposalVotesOperation(t *testing.T) {
+	got, err := UpdateProposalVotesOperation{Voter: "a", ProposalIds: []int64{5, 2}, Approve: true}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{45, 1, 97, 2, 2, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 1, 0}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+
+	if _, err = (UpdateProposalVotesOperation{Voter: "a", ProposalIds: []int64{1, 2, 3, 4, 5, 6}}).serializeOp(); err == nil {
+		t.Error("Expected an error for more than five proposal ids")
+	}
+}
+
+func TestSerializeOpUpdateProposalOperation(t *testing.T) {
+	endDate := "2016-08-08T12:24:17"
+	got, err := UpdateProposalOperation{ProposalId: 1, Creator: "a", DailyPay: "0.001 HBD", Subject: "s", Permlink: "p", EndDate: &endDate}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{47, 1, 0, 0, 0, 0, 0, 0, 0, 1, 97,
+		1, 0, 0, 0, 0, 0, 0, 0, 3, 83, 66, 68, 0, 0, 0, 0,
+		1, 115, 1, 112, 1, 1, 241, 121, 168, 87}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpCreateClaimedAccountOperation(t *testing.T) {
+	// keys derived from the seeds "a" (03 4d a0 ...) and "d" (02 1e cb ...)
+	keyA := "STM7RRMne4FT7R5kr8CKxN5LXrep7qJrqARwza2xXDtiahdtNBxGo"
+	keyD := "STM583xumcwp5hfrM2orKYgMGiUaxY6F27UDczN3xveKPYswbdjsg"
+	owner := Authority{WeightThreshold: 1, AccountAuths: map[string]uint16{"c": 1, "b": 1}, KeyAuths: map[string]uint16{keyA: 1, keyD: 1}}
+
+	got, err := CreateClaimedAccountOperation{
+		Creator:        "a",
+		NewAccountName: "n",
+		Owner:          owner,
+		Active:         NewKeyAuthority(keyA),
+		Posting:        NewKeyAuthority(keyA),
+		MemoKey:        keyD,
+		JsonMetadata:   "",
+	}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyAB, _ := publicKeyBytes(keyA)
+	keyDB, _ := publicKeyBytes(keyD)
+	var expected bytes.Buffer
+	expected.Write([]byte{23, 1, 97, 1, 110})
+	expected.Write([]byte{1, 0, 0, 0, 2, 1, 98, 1, 0, 1, 99, 1, 0, 2})
+	expected.Write(keyDB)
+	expected.Write([]byte{1, 0})
+	expected.Write(keyAB)
+	expected.Write([]byte{1, 0})
+	for i := 0; i < 2; i++ {
+		expected.Write([]byte{1, 0, 0, 0, 0, 1})
+		expected.Write(keyAB)
+		expected.Write([]byte{1, 0})
+	}
+	expected.Write(keyDB)
+	expected.Write([]byte{0, 0})
+
+	if !bytes.Equal(got, expected.Bytes()) {
+		t.Error("Expected", expected.Bytes(), "got", got)
+	}
+}
+
+func TestSerializeOpAccountUpdate2Operation(t *testing.T) {
+	got, err := AccountUpdate2Operation{Account: "a", JsonMetadata: "", PostingJsonMetadata: "{}"}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{43, 1, 97, 0, 0, 0, 0, 0, 2, 123, 125, 0}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpCustomOperation(t *testing.T) {
+	got, err := CustomOperation{RequiredAuths: []string{"b", "a"}, Id: 777, Data: "0a0b"}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{15, 2, 1, 97, 1, 98, 9, 3, 2, 10, 11}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestSerializeOpDeclineVotingRightsOperation(t *testing.T) {
+	got, err := DeclineVotingRightsOperation{Account: "a", Decline: true}.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{36, 1, 97, 1}
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/deathwingtheboss/hivego/types"
 	"github.com/decred/base58"
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 )
@@ -79,6 +80,60 @@ func SignDigest(digest []byte, wif *string) ([]byte, error) {
 	return secp256k1.SignCompact(keyPair.PrivateKey, digest, true)
 }
 
+// RecoverPublicKey returns the public key that produced a compact signature over digest.
+func RecoverPublicKey(digest []byte, signature []byte) (*secp256k1.PublicKey, error) {
+	if len(signature) != 65 {
+		return nil, errors.New("invalid compact signature length")
+	}
+
+	pubKey, _, err := secp256k1.RecoverCompact(signature, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// RecoverSignerKeys recovers the STM public key behind each hex encoded signature.
+func RecoverSignerKeys(digest []byte, signatures []string) ([]string, error) {
+	var keys []string
+	for _, sigHex := range signatures {
+		sig, err := hex.DecodeString(sigHex)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey, err := RecoverPublicKey(digest, sig)
+		if err != nil {
+			return nil, errors.New("invalid signature " + sigHex + ": " + err.Error())
+		}
+
+		keys = append(keys, *GetPublicKeyString(pubKey))
+	}
+	return keys, nil
+}
+
+// SignerKeys verifies every signature on the transaction and returns the public keys that signed it.
+func (t *HiveTransaction) SignerKeys() ([]string, error) {
+	digest, err := t.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	return RecoverSignerKeys(digest, t.Signatures)
+}
+
+// VerifyTransactionSignatures verifies every signature on a transaction
+// received from block_api and returns the public keys that signed it.
+func VerifyTransactionSignatures(tx types.Transaction) ([]string, error) {
+	hiveTx, err := HiveTransactionFromBlock(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return hiveTx.SignerKeys()
+}
+
 func GphBase58CheckDecode(input string) ([]byte, [1]byte, error) {
 	decoded := base58.Decode(input)
 	if len(decoded) < 6 {
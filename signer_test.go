@@ -2,7 +2,10 @@ package hivego
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
 )
 
 func TestHashTxForSig(t *testing.T) {
@@ -40,3 +43,41 @@ func TestGphBase58CheckDecode(t *testing.T) {
 		t.Error("Expected", expected1, "and", expected2, "got", got1, "and", got2)
 	}
 }
+
+func TestSignerKeys(t *testing.T) {
+	wif := "5JUvJcF6rQvFbZLtDFagreKCYWWcHpHApy7sbRHZ6PeZYNftLh6"
+	tx := getTestVoteTx()
+	tx.Sign(&wif)
+
+	got, err := tx.SignerKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "STM7dzxQo2aaav9weydSVAwqewcUz2GbUwyWrAVqkdiKsD6V1uX8B"
+	if len(got) != 1 || got[0] != expected {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestVerifyTransactionSignatures(t *testing.T) {
+	wif := "5JUvJcF6rQvFbZLtDFagreKCYWWcHpHApy7sbRHZ6PeZYNftLh6"
+	tx := getTestTx([]HiveOperation{TransferOperation{From: "xeroc", To: "piston", Amount: "1.500 HBD", Memo: "memo"}})
+	tx.Sign(&wif)
+
+	blockTxJs := `{"ref_block_num":36029,"ref_block_prefix":1164960351,"expiration":"2016-08-08T12:24:17",
+		"operations":[{"type":"transfer_operation","value":{"from":"xeroc","to":"piston","amount":{"amount":"1500","precision":3,"nai":"@@000000013"},"memo":"memo"}}],
+		"extensions":[],"signatures":["` + tx.Signatures[0] + `"]}`
+	var blockTx types.Transaction
+	if err := json.Unmarshal([]byte(blockTxJs), &blockTx); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := VerifyTransactionSignatures(blockTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "STM7dzxQo2aaav9weydSVAwqewcUz2GbUwyWrAVqkdiKsD6V1uX8B"
+	if len(got) != 1 || got[0] != expected {
+		t.Error("Expected", expected, "got", got)
+	}
+}
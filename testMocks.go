@@ -2,35 +2,33 @@ package hivego
 
 import "time"
 
-func getTestVoteOp() hiveOperation {
-	return voteOperation{
+func getTestVoteOp() HiveOperation {
+	return VoteOperation{
 		Voter:    "xeroc",
 		Author:   "xeroc",
 		Permlink: "piston",
 		Weight:   10000,
-		opText:   "vote",
 	}
 }
 
-func getTestCustomJsonOp() hiveOperation {
-	return customJsonOperation{
+func getTestCustomJsonOp() HiveOperation {
+	return CustomJsonOperation{
 		RequiredAuths:        []string{},
 		RequiredPostingAuths: []string{"xeroc"},
 		Id:                   "test-id",
 		Json:                 "{\"testk\":\"testv\"}",
-		opText:               "custom_json",
 	}
 }
 
-func getTwoTestOps() []hiveOperation {
-	return []hiveOperation{getTestVoteOp(), getTestCustomJsonOp()}
+func getTwoTestOps() []HiveOperation {
+	return []HiveOperation{getTestVoteOp(), getTestCustomJsonOp()}
 }
 
-func getTestTx(ops []hiveOperation) hiveTransaction {
+func getTestTx(ops []HiveOperation) HiveTransaction {
 	exp, _ := time.Parse("2006-01-02T15:04:05", "2016-08-08T12:24:17")
 	expStr := exp.Format("2006-01-02T15:04:05")
 
-	return hiveTransaction{
+	return HiveTransaction{
 		RefBlockNum:    36029,
 		RefBlockPrefix: 1164960351,
 		Expiration:     expStr,
@@ -38,6 +36,93 @@ func getTestTx(ops []hiveOperation) hiveTransaction {
 	}
 }
 
-func getTestVoteTx() hiveTransaction {
-	return getTestTx([]hiveOperation{getTestVoteOp()})
+func getTestVoteTx() HiveTransaction {
+	return getTestTx([]HiveOperation{getTestVoteOp()})
+}
+
+const (
+	testKeyA = "STM7RRMne4FT7R5kr8CKxN5LXrep7qJrqARwza2xXDtiahdtNBxGo"
+	testKeyD = "STM583xumcwp5hfrM2orKYgMGiUaxY6F27UDczN3xveKPYswbdjsg"
+)
+
+// getTestOps returns one operation of every type in getHiveOpIds.
+func getTestOps() []HiveOperation {
+	blockId := "0000000109833ce528d5bbfb3f6225b39ee10086"
+	hash := "a3a9b8fe2bb4f7d1d0e37b1e1fde9b1bb7a3e4c6bb7f0a6b6f1f1a2b3c4d5e6f"
+	signature := "1f" + hash + hash
+	pairId := uint8(1)
+	endDate := "2024-01-01T00:00:00"
+	version := "0.23.0"
+	memoKey := testKeyD
+	newOwnerKey := testKeyA
+	url := "https://example.com"
+	owner := Authority{WeightThreshold: 1, AccountAuths: map[string]uint16{"bob": 1}, KeyAuths: map[string]uint16{testKeyA: 1, testKeyD: 1}}
+	single := NewKeyAuthority(testKeyA)
+	props := ChainProperties{AccountCreationFee: "3.000 HIVE", MaximumBlockSize: 65536, HbdInterestRate: 1000}
+	header := SignedBlockHeader{
+		Previous:              blockId,
+		Timestamp:             "2016-08-08T12:24:17",
+		Witness:               "alice",
+		TransactionMerkleRoot: "0000000000000000000000000000000000000000",
+		Extensions: []BlockHeaderExtension{
+			{},
+			{Version: &version},
+			{HardforkVersionVote: &HardforkVersionVote{HfVersion: "0.24.0", HfTime: "2020-10-06T14:00:00"}},
+		},
+		WitnessSignature: signature,
+	}
+	pow2Input := Pow2Input{WorkerAccount: "alice", PrevBlock: blockId, Nonce: 1 << 40}
+
+	return []HiveOperation{
+		getTestVoteOp(),
+		CommentOperation{ParentAuthor: "", ParentPermlink: "hive", Author: "alice", Permlink: "post", Title: "Title", Body: "Body", JsonMetadata: "{}"},
+		TransferOperation{From: "alice", To: "bob", Amount: "1.000 HIVE", Memo: "1.000 HIVE"},
+		TransferToVestingOperation{From: "alice", To: "bob", Amount: "1.000 HIVE"},
+		WithdrawVestingOperation{Account: "alice", VestingShares: "100.000000 VESTS"},
+		LimitOrderCreateOperation{Owner: "alice", OrderId: 1, AmountToSell: "1.000 HIVE", MinToReceive: "0.300 HBD", FillOrKill: false, Expiration: "2016-08-08T12:24:17"},
+		LimitOrderCancelOperation{Owner: "alice", OrderId: 1},
+		FeedPublishOperation{Publisher: "alice", ExchangeRate: Price{Base: "0.300 HBD", Quote: "1.000 HIVE"}},
+		ConvertOperation{Owner: "alice", RequestId: 1, Amount: "1.000 HBD"},
+		AccountCreateOperation{Fee: "3.000 HIVE", Creator: "alice", NewAccountName: "carol", Owner: owner, Active: single, Posting: single, MemoKey: memoKey, JsonMetadata: "{}"},
+		AccountUpdateOperation{Account: "alice", Posting: &owner, MemoKey: memoKey, JsonMetadata: ""},
+		WitnessUpdateOperation{Owner: "alice", Url: url, BlockSigningKey: testKeyA, Props: props, Fee: "0.000 HIVE"},
+		AccountWitnessVoteOperation{Account: "alice", Witness: "bob", Approve: true},
+		AccountWitnessProxyOperation{Account: "alice", Proxy: "bob"},
+		PowOperation{WorkerAccount: "alice", BlockId: blockId, Nonce: 1 << 40, Work: PowWork{Worker: testKeyA, Input: hash, Signature: signature, Work: hash}, Props: props},
+		CustomOperation{RequiredAuths: []string{"bob", "alice"}, Id: 777, Data: "0a0b"},
+		ReportOverProductionOperation{Reporter: "bob", FirstBlock: header, SecondBlock: header},
+		DeleteCommentOperation{Author: "alice", Permlink: "post"},
+		getTestCustomJsonOp(),
+		CommentOptionsOperation{Author: "alice", Permlink: "post", MaxAcceptedPayout: "1000000.000 HBD", PercentHbd: 10000, AllowVotes: true, AllowCurationRewards: true, Beneficiaries: []Beneficiary{{"bob", 500}}},
+		SetWithdrawVestingRouteOperation{FromAccount: "alice", ToAccount: "bob", Percent: 5000, AutoVest: true},
+		LimitOrderCreate2Operation{Owner: "alice", OrderId: 2, AmountToSell: "1.000 HIVE", FillOrKill: true, ExchangeRate: Price{Base: "1.000 HIVE", Quote: "0.300 HBD"}, Expiration: "2016-08-08T12:24:17"},
+		ClaimAccountOperation{Creator: "alice", Fee: "0.000 HIVE"},
+		CreateClaimedAccountOperation{Creator: "alice", NewAccountName: "carol", Owner: owner, Active: single, Posting: single, MemoKey: memoKey, JsonMetadata: ""},
+		RequestAccountRecoveryOperation{RecoveryAccount: "bob", AccountToRecover: "alice", NewOwnerAuthority: single},
+		RecoverAccountOperation{AccountToRecover: "alice", NewOwnerAuthority: single, RecentOwnerAuthority: owner},
+		ChangeRecoveryAccountOperation{AccountToRecover: "alice", NewRecoveryAccount: "bob"},
+		EscrowTransferOperation{From: "alice", To: "bob", Agent: "carol", EscrowId: 1, HbdAmount: "1.000 HBD", HiveAmount: "0.000 HIVE", Fee: "0.001 HBD", RatificationDeadline: "2016-08-08T12:24:17", EscrowExpiration: "2016-08-09T12:24:17", JsonMeta: "{}"},
+		EscrowDisputeOperation{From: "alice", To: "bob", Agent: "carol", Who: "alice", EscrowId: 1},
+		EscrowReleaseOperation{From: "alice", To: "bob", Agent: "carol", Who: "carol", Receiver: "bob", EscrowId: 1, HbdAmount: "1.000 HBD", HiveAmount: "0.000 HIVE"},
+		Pow2Operation{Work: Pow2Work{Pow2: &Pow2{Input: pow2Input, PowSummary: 7}}, NewOwnerKey: &newOwnerKey, Props: props},
+		EscrowApproveOperation{From: "alice", To: "bob", Agent: "carol", Who: "bob", EscrowId: 1, Approve: true},
+		TransferToSavingsOperation{From: "alice", To: "alice", Amount: "1.000 HBD", Memo: ""},
+		TransferFromSavingsOperation{From: "alice", RequestId: 3, To: "alice", Amount: "1.000 HBD", Memo: ""},
+		CancelTransferFromSavingsOperation{From: "alice", RequestId: 3},
+		CustomBinaryOperation{RequiredPostingAuths: []string{"alice"}, RequiredAuths: []Authority{single}, Id: "follow", Data: "00ff"},
+		DeclineVotingRightsOperation{Account: "alice", Decline: true},
+		ResetAccountOperation{ResetAccount: "bob", AccountToReset: "alice", NewOwnerAuthority: single},
+		SetResetAccountOperation{Account: "alice", CurrentResetAccount: "", ResetAccount: "bob"},
+		ClaimRewardOperation{Account: "alice", RewardHBD: "0.001 HBD", RewardHIVE: "0.000 HIVE", RewardVests: "1.000000 VESTS"},
+		DelegateVestingSharesOperation{Delegator: "alice", Delegatee: "bob", VestingShares: "1000.000000 VESTS"},
+		AccountCreateWithDelegationOperation{Fee: "3.000 HIVE", Delegation: "0.000000 VESTS", Creator: "alice", NewAccountName: "carol", Owner: owner, Active: single, Posting: single, MemoKey: memoKey, JsonMetadata: ""},
+		WitnessSetPropertiesOperation{Owner: "alice", Props: WitnessProps{Key: testKeyA, Url: &url}},
+		AccountUpdate2Operation{Account: "alice", MemoKey: &memoKey, JsonMetadata: "", PostingJsonMetadata: "{}"},
+		CreateProposalOperation{Creator: "alice", Receiver: "bob", StartDate: "2023-01-01T00:00:00", EndDate: "2024-01-01T00:00:00", DailyPay: "100.000 HBD", Subject: "Proposal", Permlink: "post"},
+		UpdateProposalVotesOperation{Voter: "alice", ProposalIds: []int64{1, 2}, Approve: true},
+		RemoveProposalOperation{ProposalOwner: "alice", ProposalIds: []int64{1}},
+		UpdateProposalOperation{ProposalId: 1, Creator: "alice", DailyPay: "50.000 HBD", Subject: "Proposal", Permlink: "post", EndDate: &endDate},
+		CollateralizedConvertOperation{Owner: "alice", RequestId: 2, Amount: "10.000 HIVE"},
+		RecurrentTransferOperation{From: "alice", To: "bob", Amount: "1.000 HIVE", Memo: "", Recurrence: 24, Executions: 2, PairId: &pairId},
+	}
 }
@@ -1,5 +1,11 @@
 package hivego
 
+import (
+	"errors"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
 type TransactionQueryParams struct {
 	TransactionId     string `json:"id"`
 	IncludeReversible bool   `json:"include_reversible"`
@@ -14,3 +20,28 @@ func (h *HiveRpcNode) GetTransaction(txId string, includeReversible bool) ([]byt
 	}
 	return res, nil
 }
+
+// HiveTransactionFromBlock converts a transaction received from block_api
+// into a HiveTransaction, so that it can be serialized and its signatures checked.
+func HiveTransactionFromBlock(tx types.Transaction) (*HiveTransaction, error) {
+	hiveTx := &HiveTransaction{
+		RefBlockNum:    tx.RefBlockNum,
+		RefBlockPrefix: tx.RefBlockPrefix,
+		Expiration:     tx.Expiration,
+		Signatures:     tx.Signatures,
+	}
+
+	for _, blockOp := range tx.Operations {
+		blockOp, err := DecodeOperation(blockOp)
+		if err != nil {
+			return nil, err
+		}
+		op, ok := blockOp.Value.(HiveOperation)
+		if !ok {
+			return nil, errors.New("unsupported operation: " + blockOp.Type)
+		}
+		hiveTx.Operations = append(hiveTx.Operations, op)
+	}
+
+	return hiveTx, nil
+}
@@ -0,0 +1,119 @@
+package hivego
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+const hiveTimeLayout = "2006-01-02T15:04:05"
+
+// TransactionBuilder collects operations and the reference block data
+// needed to produce a HiveTransaction. The reference block and expiration
+// can be taken from a node with FillFromNode or supplied by the caller,
+// which allows transactions to be built and signed offline.
+type TransactionBuilder struct {
+	operations     []HiveOperation
+	refBlockNum    uint16
+	refBlockPrefix uint32
+	refBlockSet    bool
+	expiration     time.Time
+}
+
+func NewTransactionBuilder(ops ...HiveOperation) *TransactionBuilder {
+	return &TransactionBuilder{operations: ops}
+}
+
+// AddOperation appends operations to the transaction, in order.
+func (b *TransactionBuilder) AddOperation(ops ...HiveOperation) *TransactionBuilder {
+	b.operations = append(b.operations, ops...)
+	return b
+}
+
+// SetRefBlock sets the TaPoS reference block number and prefix directly.
+func (b *TransactionBuilder) SetRefBlock(refBlockNum uint16, refBlockPrefix uint32) *TransactionBuilder {
+	b.refBlockNum = refBlockNum
+	b.refBlockPrefix = refBlockPrefix
+	b.refBlockSet = true
+	return b
+}
+
+// SetRefBlockFromId derives the reference block number and prefix from a block number and its id.
+func (b *TransactionBuilder) SetRefBlockFromId(blockNum int, blockId string) error {
+	idB, err := hex.DecodeString(blockId)
+	if err != nil {
+		return err
+	}
+	if len(idB) < 8 {
+		return errors.New("block id too short: " + blockId)
+	}
+
+	b.SetRefBlock(uint16(blockNum&0xffff), binary.LittleEndian.Uint32(idB[4:]))
+	return nil
+}
+
+// SetExpiration sets the time after which the transaction is no longer valid.
+func (b *TransactionBuilder) SetExpiration(expiration time.Time) *TransactionBuilder {
+	b.expiration = expiration.UTC()
+	return b
+}
+
+// FillFromNode takes the reference block from the node's current head block.
+// If no expiration was set, it expires 30 seconds after the head block time.
+func (b *TransactionBuilder) FillFromNode(node *HiveRpcNode) error {
+	signingData, err := node.getSigningData()
+	if err != nil {
+		return err
+	}
+
+	b.SetRefBlock(signingData.refBlockNum, signingData.refBlockPrefix)
+
+	if b.expiration.IsZero() {
+		exp, err := time.Parse(hiveTimeLayout, signingData.expiration)
+		if err != nil {
+			return err
+		}
+		b.expiration = exp
+	}
+
+	return nil
+}
+
+// Build returns the unsigned transaction.
+func (b *TransactionBuilder) Build() (*HiveTransaction, error) {
+	if len(b.operations) == 0 {
+		return nil, errors.New("transaction has no operations")
+	}
+	if !b.refBlockSet {
+		return nil, errors.New("reference block not set")
+	}
+	if b.expiration.IsZero() {
+		return nil, errors.New("expiration not set")
+	}
+
+	ops := make([]HiveOperation, len(b.operations))
+	copy(ops, b.operations)
+
+	return &HiveTransaction{
+		RefBlockNum:    b.refBlockNum,
+		RefBlockPrefix: b.refBlockPrefix,
+		Expiration:     b.expiration.Format(hiveTimeLayout),
+		Operations:     ops,
+	}, nil
+}
+
+// Sign builds the transaction and signs it with the given WIF.
+func (b *TransactionBuilder) Sign(wif *string) (*HiveTransaction, error) {
+	tx, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Sign(wif)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
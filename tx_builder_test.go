@@ -0,0 +1,47 @@
+package hivego
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransactionBuilderBuild(t *testing.T) {
+	exp, _ := time.Parse(hiveTimeLayout, "2016-08-08T12:24:17")
+	tx, err := NewTransactionBuilder(getTestVoteOp()).SetRefBlock(36029, 1164960351).SetExpiration(exp).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := tx.TrxId()
+	expected := "12164dcee518674c586e6a61d08623c44980e326"
+	if got != expected {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestTransactionBuilderRequiresRefBlock(t *testing.T) {
+	_, err := NewTransactionBuilder(getTestVoteOp()).SetExpiration(time.Now()).Build()
+	if err == nil {
+		t.Error("Expected an error for a missing reference block")
+	}
+}
+
+func TestTransactionBuilderSign(t *testing.T) {
+	wif := "5JuMt237G3m3BaT7zH4YdoycUtbw4AEPy6DLdCrKAnFGAtXyQ1W"
+	exp, _ := time.Parse(hiveTimeLayout, "2016-08-08T12:24:17")
+	b := NewTransactionBuilder().AddOperation(getTwoTestOps()...).SetExpiration(exp)
+	if err := b.SetRefBlockFromId(36029, "00008cbd5fe26f4500000000000000000000000000000000"); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := b.Sign(&wif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.RefBlockPrefix != 1164960351 {
+		t.Error("Expected", 1164960351, "got", tx.RefBlockPrefix)
+	}
+	if len(tx.Signatures) != 1 || len(tx.Signatures[0]) != 130 {
+		t.Error("Expected one 65 byte signature, got", tx.Signatures)
+	}
+}
@@ -105,3 +105,35 @@ func (ct *CustomTime) UnmarshalJSON(b []byte) error {
 func (ct CustomTime) ToTime() time.Time {
 	return time.Time(ct)
 }
+
+// KeyWeights returns the key auths as a map of public key to weight.
+func (a Authority) KeyWeights() map[string]int {
+	return authWeights(a.KeyAuths)
+}
+
+// AccountWeights returns the account auths as a map of account name to weight.
+func (a Authority) AccountWeights() map[string]int {
+	return authWeights(a.AccountAuths)
+}
+
+func authWeights(auths [][]interface{}) map[string]int {
+	weights := make(map[string]int, len(auths))
+	for _, auth := range auths {
+		if len(auth) != 2 {
+			continue
+		}
+		name, ok := auth[0].(string)
+		if !ok {
+			continue
+		}
+		switch weight := auth[1].(type) {
+		case float64:
+			weights[name] = int(weight)
+		case int:
+			weights[name] = weight
+		case uint16:
+			weights[name] = int(weight)
+		}
+	}
+	return weights
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// AccountHistoryEntry is an operation of an account's history. Seq numbers
+// the operations of the account from 0 up.
+type AccountHistoryEntry struct {
+	Seq int64
+	OperationObject
+}
+
+// UnmarshalJSON decodes the [seq, operation] pair returned by get_account_history.
+func (e *AccountHistoryEntry) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	err := json.Unmarshal(data, &pair)
+	if err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return errors.New("invalid account history entry: " + string(data))
+	}
+
+	err = json.Unmarshal(pair[0], &e.Seq)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], &e.OperationObject)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// NaiSymbols maps the appbase asset identifiers to their symbols.
+var NaiSymbols = map[string]string{
+	"@@000000021": "HIVE",
+	"@@000000013": "HBD",
+	"@@000000037": "VESTS",
+}
+
+// Asset is an amount in the smallest unit of its symbol. It decodes from
+// both the legacy "1.000 HIVE" string and the appbase {amount, precision, nai} object.
+type Asset struct {
+	Amount    int64
+	Precision int
+	Symbol    string
+}
+
+func (a *Asset) UnmarshalJSON(b []byte) error {
+	var legacy string
+	if json.Unmarshal(b, &legacy) == nil {
+		return a.parseLegacy(legacy)
+	}
+
+	var nai struct {
+		Amount    string `json:"amount"`
+		Precision int    `json:"precision"`
+		Nai       string `json:"nai"`
+	}
+	err := json.Unmarshal(b, &nai)
+	if err != nil {
+		return err
+	}
+
+	symbol, ok := NaiSymbols[nai.Nai]
+	if !ok {
+		return errors.New("unknown asset nai: " + nai.Nai)
+	}
+	amount, err := strconv.ParseInt(nai.Amount, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*a = Asset{amount, nai.Precision, symbol}
+	return nil
+}
+
+func (a *Asset) parseLegacy(s string) error {
+	parts := strings.Split(s, " ")
+	if len(parts) != 2 {
+		return errors.New("invalid asset format: " + s)
+	}
+
+	number := parts[0]
+	precision := 0
+	if i := strings.Index(number, "."); i >= 0 {
+		precision = len(number) - i - 1
+		number = number[:i] + number[i+1:]
+	}
+	amount, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*a = Asset{amount, precision, parts[1]}
+	return nil
+}
+
+func (a Asset) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// String formats the asset as a legacy asset string such as "1.000 HIVE".
+func (a Asset) String() string {
+	digits := strconv.FormatInt(a.Amount, 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign = "-"
+		digits = digits[1:]
+	}
+	if len(digits) <= a.Precision {
+		digits = strings.Repeat("0", a.Precision-len(digits)+1) + digits
+	}
+
+	whole, frac := digits[:len(digits)-a.Precision], digits[len(digits)-a.Precision:]
+	if a.Precision == 0 {
+		return sign + whole + " " + a.Symbol
+	}
+	return sign + whole + "." + frac + " " + a.Symbol
+}
+
+// Price is an exchange rate as api results return it. Operations take
+// hivego.Price instead, which hivego.PriceFromTypes converts to.
+type Price struct {
+	Base  Asset `json:"base"`
+	Quote Asset `json:"quote"`
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/json"
+	"errors"
+)
+
 type GetBlockRangeQueryParams struct {
 	StartingBlockNum int `json:"starting_block_num"`
 	Count            int `json:"count"`
@@ -23,6 +28,33 @@ type Block struct {
 	WitnessSignature      string        `json:"witness_signature"`
 }
 
+// OperationObject is an operation with its position in the chain, as returned
+// by the account history api. VirtualOp is set for operations generated by the
+// chain; their TrxInBlock is past the last transaction of the block when they
+// are not caused by a transaction.
+type OperationObject struct {
+	TrxId      string    `json:"trx_id"`
+	Block      int       `json:"block"`
+	TrxInBlock uint32    `json:"trx_in_block"`
+	OpInTrx    uint32    `json:"op_in_trx"`
+	VirtualOp  bool      `json:"virtual_op"`
+	Timestamp  string    `json:"timestamp"`
+	Op         Operation `json:"op"`
+}
+
+type EnumVirtualOpsQueryParams struct {
+	BlockRangeBegin   int    `json:"block_range_begin"`
+	BlockRangeEnd     int    `json:"block_range_end"`
+	OperationBegin    uint64 `json:"operation_begin,omitempty"`
+	IncludeReversible bool   `json:"include_reversible"`
+}
+
+type EnumVirtualOpsResult struct {
+	Ops                 []OperationObject `json:"ops"`
+	NextBlockRangeBegin int               `json:"next_block_range_begin"`
+	NextOperationBegin  json.Number       `json:"next_operation_begin"`
+}
+
 type Transaction struct {
 	Expiration           string        `json:"expiration"`
 	Extensions           []interface{} `json:"extensions"`
@@ -34,9 +66,53 @@ type Transaction struct {
 	RequiredPostingAuths []string      `json:"required_posting_auths,omitempty"`
 }
 
+// Operation is an operation of a block transaction. Type is the appbase name,
+// such as "vote_operation". UnmarshalJSON sets Value to the operation's JSON
+// object as a map[string]interface{}, and RawValue to that object as received.
+// hivego.DecodeOperation replaces Value with the typed operation, such as
+// hivego.VoteOperation; the hivego methods that return operations from a node
+// do so already.
 type Operation struct {
-	Type  string                 `json:"type"`
-	Value map[string]interface{} `json:"value"`
+	Type     string          `json:"type"`
+	Value    interface{}     `json:"value"`
+	RawValue json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an operation in the appbase {"type", "value"} form or
+// the condenser [name, value] form.
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+
+	var pair []json.RawMessage
+	if json.Unmarshal(data, &pair) == nil {
+		if len(pair) != 2 {
+			return errors.New("invalid operation: " + string(data))
+		}
+		err := json.Unmarshal(pair[0], &raw.Type)
+		if err != nil {
+			return err
+		}
+		raw.Type += "_operation"
+		raw.Value = pair[1]
+	} else {
+		err := json.Unmarshal(data, &raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	var value map[string]interface{}
+	err := json.Unmarshal(raw.Value, &value)
+	if err != nil {
+		return err
+	}
+	o.Type = raw.Type
+	o.Value = value
+	o.RawValue = append(json.RawMessage{}, raw.Value...)
+	return nil
 }
 
 type operationTypes struct {
@@ -90,6 +166,26 @@ type operationTypes struct {
 	UpdateProposal              string
 	CollateralizedConvert       string
 	RecurrentTransfer           string
+
+	// virtual operations
+	FillConvertRequest               string
+	AuthorReward                     string
+	CurationReward                   string
+	CommentReward                    string
+	LiquidityReward                  string
+	Interest                         string
+	FillVestingWithdraw              string
+	FillOrder                        string
+	FillTransferFromSavings          string
+	ReturnVestingDelegation          string
+	CommentBenefactorReward          string
+	ProducerReward                   string
+	ProposalPay                      string
+	TransferToVestingCompleted       string
+	AccountCreated                   string
+	FillCollateralizedConvertRequest string
+	FillRecurrentTransfer            string
+	FailedRecurrentTransfer          string
 }
 
 var OperationType = operationTypes{
@@ -143,4 +239,23 @@ var OperationType = operationTypes{
 	UpdateProposal:              "update_proposal_operation",
 	CollateralizedConvert:       "collateralized_convert_operation",
 	RecurrentTransfer:           "recurrent_transfer_operation",
+
+	FillConvertRequest:               "fill_convert_request_operation",
+	AuthorReward:                     "author_reward_operation",
+	CurationReward:                   "curation_reward_operation",
+	CommentReward:                    "comment_reward_operation",
+	LiquidityReward:                  "liquidity_reward_operation",
+	Interest:                         "interest_operation",
+	FillVestingWithdraw:              "fill_vesting_withdraw_operation",
+	FillOrder:                        "fill_order_operation",
+	FillTransferFromSavings:          "fill_transfer_from_savings_operation",
+	ReturnVestingDelegation:          "return_vesting_delegation_operation",
+	CommentBenefactorReward:          "comment_benefactor_reward_operation",
+	ProducerReward:                   "producer_reward_operation",
+	ProposalPay:                      "proposal_pay_operation",
+	TransferToVestingCompleted:       "transfer_to_vesting_completed_operation",
+	AccountCreated:                   "account_created_operation",
+	FillCollateralizedConvertRequest: "fill_collateralized_convert_request_operation",
+	FillRecurrentTransfer:            "fill_recurrent_transfer_operation",
+	FailedRecurrentTransfer:          "failed_recurrent_transfer_operation",
 }
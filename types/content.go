@@ -0,0 +1,42 @@
+package types
+
+type ActiveVote struct {
+	Voter   string `json:"voter"`
+	Weight  int64  `json:"weight"`
+	Rshares int64  `json:"rshares"`
+	Percent int16  `json:"percent"`
+	Time    string `json:"time"`
+}
+
+type Content struct {
+	ID                   int64  `json:"id"`
+	Author               string `json:"author"`
+	Permlink             string `json:"permlink"`
+	Category             string `json:"category"`
+	ParentAuthor         string `json:"parent_author"`
+	ParentPermlink       string `json:"parent_permlink"`
+	Title                string `json:"title"`
+	Body                 string `json:"body"`
+	JSONMetadata         string `json:"json_metadata"`
+	LastUpdate           string `json:"last_update"`
+	Created              string `json:"created"`
+	CashoutTime          string `json:"cashout_time"`
+	LastPayout           string `json:"last_payout"`
+	Depth                int    `json:"depth"`
+	Children             int    `json:"children"`
+	NetRshares           int64  `json:"net_rshares"`
+	NetVotes             int    `json:"net_votes"`
+	MaxAcceptedPayout    string `json:"max_accepted_payout"`
+	PercentHbd           uint16 `json:"percent_hbd"`
+	AllowReplies         bool   `json:"allow_replies"`
+	AllowVotes           bool   `json:"allow_votes"`
+	AllowCurationRewards bool   `json:"allow_curation_rewards"`
+	Beneficiaries        []struct {
+		Account string `json:"account"`
+		Weight  uint16 `json:"weight"`
+	} `json:"beneficiaries"`
+	PendingPayoutValue string       `json:"pending_payout_value"`
+	TotalPayoutValue   string       `json:"total_payout_value"`
+	CuratorPayoutValue string       `json:"curator_payout_value"`
+	ActiveVotes        []ActiveVote `json:"active_votes"`
+}
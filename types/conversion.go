@@ -0,0 +1,29 @@
+package types
+
+type ConversionRequest struct {
+	ID             int64  `json:"id"`
+	Owner          string `json:"owner"`
+	RequestID      uint32 `json:"requestid"`
+	Amount         string `json:"amount"`
+	ConversionDate string `json:"conversion_date"`
+}
+
+type CollateralizedConversionRequest struct {
+	ID               int64  `json:"id"`
+	Owner            string `json:"owner"`
+	RequestID        uint32 `json:"requestid"`
+	CollateralAmount string `json:"collateral_amount"`
+	ConvertedAmount  string `json:"converted_amount"`
+	ConversionDate   string `json:"conversion_date"`
+}
+
+// FeedHistory is the price feed history the chain converts with. Collateralized
+// conversions use CurrentMinHistory, conversions of HBD use CurrentMedianHistory.
+type FeedHistory struct {
+	ID                   int64   `json:"id"`
+	CurrentMedianHistory Price   `json:"current_median_history"`
+	MarketMedianHistory  Price   `json:"market_median_history"`
+	CurrentMinHistory    Price   `json:"current_min_history"`
+	CurrentMaxHistory    Price   `json:"current_max_history"`
+	PriceHistory         []Price `json:"price_history"`
+}
@@ -0,0 +1,17 @@
+package types
+
+type Escrow struct {
+	ID                   int64  `json:"id"`
+	EscrowID             uint32 `json:"escrow_id"`
+	From                 string `json:"from"`
+	To                   string `json:"to"`
+	Agent                string `json:"agent"`
+	RatificationDeadline string `json:"ratification_deadline"`
+	EscrowExpiration     string `json:"escrow_expiration"`
+	HbdBalance           string `json:"hbd_balance"`
+	HiveBalance          string `json:"hive_balance"`
+	PendingFee           string `json:"pending_fee"`
+	ToApproved           bool   `json:"to_approved"`
+	AgentApproved        bool   `json:"agent_approved"`
+	Disputed             bool   `json:"disputed"`
+}
@@ -0,0 +1,41 @@
+package types
+
+type MarketOrder struct {
+	OrderPrice Price  `json:"order_price"`
+	RealPrice  string `json:"real_price"`
+	Hive       int64  `json:"hive"`
+	Hbd        int64  `json:"hbd"`
+	Created    string `json:"created"`
+}
+
+type OrderBook struct {
+	Bids []MarketOrder `json:"bids"`
+	Asks []MarketOrder `json:"asks"`
+}
+
+type OpenOrder struct {
+	ID         int64  `json:"id"`
+	Created    string `json:"created"`
+	Expiration string `json:"expiration"`
+	Seller     string `json:"seller"`
+	OrderID    uint32 `json:"orderid"`
+	ForSale    int64  `json:"for_sale"`
+	SellPrice  Price  `json:"sell_price"`
+	RealPrice  string `json:"real_price"`
+	Rewarded   bool   `json:"rewarded"`
+}
+
+type Ticker struct {
+	Latest        string `json:"latest"`
+	LowestAsk     string `json:"lowest_ask"`
+	HighestBid    string `json:"highest_bid"`
+	PercentChange string `json:"percent_change"`
+	HiveVolume    Asset  `json:"hive_volume"`
+	HbdVolume     Asset  `json:"hbd_volume"`
+}
+
+type MarketTrade struct {
+	Date        string `json:"date"`
+	CurrentPays Asset  `json:"current_pays"`
+	OpenPays    Asset  `json:"open_pays"`
+}
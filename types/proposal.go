@@ -0,0 +1,23 @@
+package types
+
+import "encoding/json"
+
+type Proposal struct {
+	ID         int64       `json:"id"`
+	ProposalID int64       `json:"proposal_id"`
+	Creator    string      `json:"creator"`
+	Receiver   string      `json:"receiver"`
+	StartDate  string      `json:"start_date"`
+	EndDate    string      `json:"end_date"`
+	DailyPay   Asset       `json:"daily_pay"`
+	Subject    string      `json:"subject"`
+	Permlink   string      `json:"permlink"`
+	TotalVotes json.Number `json:"total_votes"`
+	Status     string      `json:"status"`
+}
+
+type ProposalVote struct {
+	ID       int64    `json:"id"`
+	Voter    string   `json:"voter"`
+	Proposal Proposal `json:"proposal"`
+}
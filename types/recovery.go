@@ -0,0 +1,15 @@
+package types
+
+type AccountRecoveryRequest struct {
+	ID                int64     `json:"id"`
+	AccountToRecover  string    `json:"account_to_recover"`
+	NewOwnerAuthority Authority `json:"new_owner_authority"`
+	Expires           string    `json:"expires"`
+}
+
+type OwnerAuthorityHistory struct {
+	ID                     int64     `json:"id"`
+	Account                string    `json:"account"`
+	PreviousOwnerAuthority Authority `json:"previous_owner_authority"`
+	LastValidTime          string    `json:"last_valid_time"`
+}
@@ -0,0 +1,14 @@
+package types
+
+type RecurrentTransfer struct {
+	ID                  int64  `json:"id"`
+	TriggerDate         string `json:"trigger_date"`
+	From                string `json:"from"`
+	To                  string `json:"to"`
+	Amount              string `json:"amount"`
+	Memo                string `json:"memo"`
+	Recurrence          uint16 `json:"recurrence"`
+	ConsecutiveFailures uint8  `json:"consecutive_failures"`
+	RemainingExecutions uint16 `json:"remaining_executions"`
+	PairID              uint8  `json:"pair_id"`
+}
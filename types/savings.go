@@ -0,0 +1,11 @@
+package types
+
+type SavingsWithdraw struct {
+	ID        int64  `json:"id"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Memo      string `json:"memo"`
+	RequestID uint32 `json:"request_id"`
+	Amount    string `json:"amount"`
+	Complete  string `json:"complete"`
+}
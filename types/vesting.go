@@ -0,0 +1,16 @@
+package types
+
+type VestingDelegation struct {
+	ID                int64  `json:"id"`
+	Delegator         string `json:"delegator"`
+	Delegatee         string `json:"delegatee"`
+	VestingShares     string `json:"vesting_shares"`
+	MinDelegationTime string `json:"min_delegation_time"`
+}
+
+type ExpiringVestingDelegation struct {
+	ID            int64  `json:"id"`
+	Delegator     string `json:"delegator"`
+	VestingShares string `json:"vesting_shares"`
+	Expiration    string `json:"expiration"`
+}
@@ -0,0 +1,20 @@
+package types
+
+type Witness struct {
+	ID                    int64  `json:"id"`
+	Owner                 string `json:"owner"`
+	Created               string `json:"created"`
+	Url                   string `json:"url"`
+	Votes                 string `json:"votes"`
+	TotalMissed           int64  `json:"total_missed"`
+	LastConfirmedBlockNum int64  `json:"last_confirmed_block_num"`
+	SigningKey            string `json:"signing_key"`
+	Props                 struct {
+		AccountCreationFee string `json:"account_creation_fee"`
+		MaximumBlockSize   uint32 `json:"maximum_block_size"`
+		HbdInterestRate    uint16 `json:"hbd_interest_rate"`
+	} `json:"props"`
+	HbdExchangeRate       Price  `json:"hbd_exchange_rate"`
+	LastHbdExchangeUpdate string `json:"last_hbd_exchange_update"`
+	RunningVersion        string `json:"running_version"`
+}
@@ -0,0 +1,220 @@
+package hivego
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type TransferToVestingOperation struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+}
+
+func (o TransferToVestingOperation) opName() string {
+	return "transfer_to_vesting"
+}
+
+func (o TransferToVestingOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.From}}
+}
+
+// PowerUp converts liquid HIVE of from into Hive Power of to. An empty to powers up from itself.
+func (h *HiveRpcNode) PowerUp(from string, to string, amount string, wif *string) (string, error) {
+	if to == "" {
+		to = from
+	}
+	op := TransferToVestingOperation{from, to, amount}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type WithdrawVestingOperation struct {
+	Account       string `json:"account"`
+	VestingShares string `json:"vesting_shares"`
+}
+
+func (o WithdrawVestingOperation) opName() string {
+	return "withdraw_vesting"
+}
+
+func (o WithdrawVestingOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Account}}
+}
+
+// PowerDown starts a power down of the given VESTS amount, replacing any running one.
+// A zero amount such as "0.000000 VESTS" stops the power down.
+func (h *HiveRpcNode) PowerDown(account string, vestingShares string, wif *string) (string, error) {
+	op := WithdrawVestingOperation{account, vestingShares}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type SetWithdrawVestingRouteOperation struct {
+	FromAccount string `json:"from_account"`
+	ToAccount   string `json:"to_account"`
+	Percent     uint16 `json:"percent"`
+	AutoVest    bool   `json:"auto_vest"`
+}
+
+func (o SetWithdrawVestingRouteOperation) opName() string {
+	return "set_withdraw_vesting_route"
+}
+
+func (o SetWithdrawVestingRouteOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.FromAccount}}
+}
+
+// SetWithdrawVestingRoute routes percent (in basis points, 10000 = 100%) of
+// each power down payment to another account, optionally as Hive Power.
+// A percent of 0 removes the route.
+func (h *HiveRpcNode) SetWithdrawVestingRoute(from string, to string, percent uint16, autoVest bool, wif *string) (string, error) {
+	op := SetWithdrawVestingRouteOperation{from, to, percent, autoVest}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type DelegateVestingSharesOperation struct {
+	Delegator     string `json:"delegator"`
+	Delegatee     string `json:"delegatee"`
+	VestingShares string `json:"vesting_shares"`
+}
+
+func (o DelegateVestingSharesOperation) opName() string {
+	return "delegate_vesting_shares"
+}
+
+func (o DelegateVestingSharesOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Delegator}}
+}
+
+// DelegateVestingShares sets the VESTS delegated from delegator to delegatee.
+// Delegating "0.000000 VESTS" removes the delegation.
+func (h *HiveRpcNode) DelegateVestingShares(delegator string, delegatee string, vestingShares string, wif *string) (string, error) {
+	op := DelegateVestingSharesOperation{delegator, delegatee, vestingShares}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// DelegateHp sets the delegation from delegator to delegatee to the given
+// amount of Hive Power, such as "100.000 HIVE", converted to VESTS at the current rate.
+func (h *HiveRpcNode) DelegateHp(delegator string, delegatee string, hp string, wif *string) (string, error) {
+	vests, err := h.HpToVests(hp)
+	if err != nil {
+		return "", err
+	}
+
+	return h.DelegateVestingShares(delegator, delegatee, vests, wif)
+}
+
+func (h *HiveRpcNode) getVestingRate() (string, string, error) {
+	propsB, err := h.GetDynamicGlobalProps()
+	if err != nil {
+		return "", "", err
+	}
+
+	var props globalProps
+	err = json.Unmarshal(propsB, &props)
+	if err != nil {
+		return "", "", err
+	}
+
+	return props.TotalVestingFundHive, props.TotalVestingShares, nil
+}
+
+// HpToVests converts a Hive Power amount such as "100.000 HIVE" to VESTS at the current rate.
+func (h *HiveRpcNode) HpToVests(hp string) (string, error) {
+	totalFund, totalShares, err := h.getVestingRate()
+	if err != nil {
+		return "", err
+	}
+
+	return convertVesting(hp, "HIVE", totalShares, totalFund)
+}
+
+// VestsToHp converts a VESTS amount to Hive Power at the current rate.
+func (h *HiveRpcNode) VestsToHp(vests string) (string, error) {
+	totalFund, totalShares, err := h.getVestingRate()
+	if err != nil {
+		return "", err
+	}
+
+	return convertVesting(vests, "VESTS", totalFund, totalShares)
+}
+
+// convertVesting converts amount, in symbol, by the ratio to/from of the
+// vesting fund totals, rounding down, and returns it in the symbol of to.
+func convertVesting(amount string, symbol string, to string, from string) (string, error) {
+	err := checkAssetSymbol(amount, symbol)
+	if err != nil {
+		return "", err
+	}
+
+	value, _, _, err := parseAsset(amount)
+	if err != nil {
+		return "", err
+	}
+	toValue, toPrecision, toSymbol, err := parseAsset(to)
+	if err != nil {
+		return "", err
+	}
+	fromValue, _, _, err := parseAsset(from)
+	if err != nil {
+		return "", err
+	}
+	if fromValue == 0 {
+		return "", errors.New("vesting fund is empty")
+	}
+
+	converted := new(big.Int).Mul(big.NewInt(value), big.NewInt(toValue))
+	converted.Quo(converted, big.NewInt(fromValue))
+	if !converted.IsInt64() {
+		return "", errors.New("converted amount overflows: " + amount)
+	}
+
+	return formatAsset(converted.Int64(), toPrecision, toSymbol), nil
+}
+
+// GetVestingDelegations lists the outgoing delegations of account, ordered by
+// delegatee and starting at from. At most 1000 are returned per call.
+func (h *HiveRpcNode) GetVestingDelegations(account string, from string, limit int) ([]types.VestingDelegation, error) {
+	var query = hrpcQuery{
+		method: "condenser_api.get_vesting_delegations",
+		params: []interface{}{account, from, limit},
+	}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []types.VestingDelegation
+	err = json.Unmarshal(res, &delegations)
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+// GetExpiringVestingDelegations lists removed delegations of account whose
+// VESTS have not yet returned, expiring after the given time.
+func (h *HiveRpcNode) GetExpiringVestingDelegations(account string, after time.Time, limit int) ([]types.ExpiringVestingDelegation, error) {
+	var query = hrpcQuery{
+		method: "condenser_api.get_expiring_vesting_delegations",
+		params: []interface{}{account, after.UTC().Format(hiveTimeLayout), limit},
+	}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []types.ExpiringVestingDelegation
+	err = json.Unmarshal(res, &delegations)
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
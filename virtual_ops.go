@@ -0,0 +1,405 @@
+package hivego
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+// Virtual operations are generated by the chain itself, for payouts, order
+// fills and the like. They are never signed or broadcast, and are not part of
+// the transactions returned by the block api. The methods below return them
+// decoded into the typed operations below; virtual operations without a type
+// here keep their generic map value.
+
+type FillConvertRequestOperation struct {
+	Owner     string `json:"owner"`
+	RequestId uint32 `json:"requestid"`
+	AmountIn  string `json:"amount_in"`
+	AmountOut string `json:"amount_out"`
+}
+
+type AuthorRewardOperation struct {
+	Author                string `json:"author"`
+	Permlink              string `json:"permlink"`
+	HbdPayout             string `json:"hbd_payout"`
+	HivePayout            string `json:"hive_payout"`
+	VestingPayout         string `json:"vesting_payout"`
+	CuratorsVestingPayout string `json:"curators_vesting_payout"`
+	PayoutMustBeClaimed   bool   `json:"payout_must_be_claimed"`
+}
+
+type CurationRewardOperation struct {
+	Curator             string `json:"curator"`
+	Reward              string `json:"reward"`
+	CommentAuthor       string `json:"comment_author"`
+	CommentPermlink     string `json:"comment_permlink"`
+	PayoutMustBeClaimed bool   `json:"payout_must_be_claimed"`
+}
+
+type CommentRewardOperation struct {
+	Author                 string `json:"author"`
+	Permlink               string `json:"permlink"`
+	Payout                 string `json:"payout"`
+	AuthorRewards          int64  `json:"author_rewards"`
+	TotalPayoutValue       string `json:"total_payout_value"`
+	CuratorPayoutValue     string `json:"curator_payout_value"`
+	BeneficiaryPayoutValue string `json:"beneficiary_payout_value"`
+}
+
+type LiquidityRewardOperation struct {
+	Owner  string `json:"owner"`
+	Payout string `json:"payout"`
+}
+
+// InterestOperation pays the interest on HBD savings.
+type InterestOperation struct {
+	Owner                 string `json:"owner"`
+	Interest              string `json:"interest"`
+	IsSavedIntoHbdBalance bool   `json:"is_saved_into_hbd_balance"`
+}
+
+type FillVestingWithdrawOperation struct {
+	FromAccount string `json:"from_account"`
+	ToAccount   string `json:"to_account"`
+	Withdrawn   string `json:"withdrawn"`
+	Deposited   string `json:"deposited"`
+}
+
+// FillOrderOperation matches two orders of the internal market. The current
+// order is the one that was just placed, the open order the one it filled.
+type FillOrderOperation struct {
+	CurrentOwner   string `json:"current_owner"`
+	CurrentOrderId uint32 `json:"current_orderid"`
+	CurrentPays    string `json:"current_pays"`
+	OpenOwner      string `json:"open_owner"`
+	OpenOrderId    uint32 `json:"open_orderid"`
+	OpenPays       string `json:"open_pays"`
+}
+
+type FillTransferFromSavingsOperation struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Amount    string `json:"amount"`
+	RequestId uint32 `json:"request_id"`
+	Memo      string `json:"memo"`
+}
+
+type ReturnVestingDelegationOperation struct {
+	Account       string `json:"account"`
+	VestingShares string `json:"vesting_shares"`
+}
+
+type CommentBenefactorRewardOperation struct {
+	Benefactor          string `json:"benefactor"`
+	Author              string `json:"author"`
+	Permlink            string `json:"permlink"`
+	HbdPayout           string `json:"hbd_payout"`
+	HivePayout          string `json:"hive_payout"`
+	VestingPayout       string `json:"vesting_payout"`
+	PayoutMustBeClaimed bool   `json:"payout_must_be_claimed"`
+}
+
+type ProducerRewardOperation struct {
+	Producer      string `json:"producer"`
+	VestingShares string `json:"vesting_shares"`
+}
+
+type ProposalPayOperation struct {
+	ProposalId uint32 `json:"proposal_id"`
+	Receiver   string `json:"receiver"`
+	Payer      string `json:"payer"`
+	Payment    string `json:"payment"`
+}
+
+type TransferToVestingCompletedOperation struct {
+	FromAccount           string `json:"from_account"`
+	ToAccount             string `json:"to_account"`
+	HiveVested            string `json:"hive_vested"`
+	VestingSharesReceived string `json:"vesting_shares_received"`
+}
+
+type AccountCreatedOperation struct {
+	NewAccountName       string `json:"new_account_name"`
+	Creator              string `json:"creator"`
+	InitialVestingShares string `json:"initial_vesting_shares"`
+	InitialDelegation    string `json:"initial_delegation"`
+}
+
+type FillCollateralizedConvertRequestOperation struct {
+	Owner            string `json:"owner"`
+	RequestId        uint32 `json:"requestid"`
+	AmountIn         string `json:"amount_in"`
+	AmountOut        string `json:"amount_out"`
+	ExcessCollateral string `json:"excess_collateral"`
+}
+
+type FillRecurrentTransferOperation struct {
+	From                string `json:"from"`
+	To                  string `json:"to"`
+	Amount              string `json:"amount"`
+	Memo                string `json:"memo"`
+	RemainingExecutions uint16 `json:"remaining_executions"`
+}
+
+type FailedRecurrentTransferOperation struct {
+	From                string `json:"from"`
+	To                  string `json:"to"`
+	Amount              string `json:"amount"`
+	Memo                string `json:"memo"`
+	ConsecutiveFailures uint8  `json:"consecutive_failures"`
+	RemainingExecutions uint16 `json:"remaining_executions"`
+	Deleted             bool   `json:"deleted"`
+}
+
+func decodeVirtualOp[T any](data []byte) (interface{}, error) {
+	var op T
+	err := json.Unmarshal(data, &op)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+var virtualOpDecoders = map[string]func(data []byte) (interface{}, error){
+	"fill_convert_request":                decodeVirtualOp[FillConvertRequestOperation],
+	"author_reward":                       decodeVirtualOp[AuthorRewardOperation],
+	"curation_reward":                     decodeVirtualOp[CurationRewardOperation],
+	"comment_reward":                      decodeVirtualOp[CommentRewardOperation],
+	"liquidity_reward":                    decodeVirtualOp[LiquidityRewardOperation],
+	"interest":                            decodeVirtualOp[InterestOperation],
+	"fill_vesting_withdraw":               decodeVirtualOp[FillVestingWithdrawOperation],
+	"fill_order":                          decodeVirtualOp[FillOrderOperation],
+	"fill_transfer_from_savings":          decodeVirtualOp[FillTransferFromSavingsOperation],
+	"return_vesting_delegation":           decodeVirtualOp[ReturnVestingDelegationOperation],
+	"comment_benefactor_reward":           decodeVirtualOp[CommentBenefactorRewardOperation],
+	"producer_reward":                     decodeVirtualOp[ProducerRewardOperation],
+	"proposal_pay":                        decodeVirtualOp[ProposalPayOperation],
+	"transfer_to_vesting_completed":       decodeVirtualOp[TransferToVestingCompletedOperation],
+	"account_created":                     decodeVirtualOp[AccountCreatedOperation],
+	"fill_collateralized_convert_request": decodeVirtualOp[FillCollateralizedConvertRequestOperation],
+	"fill_recurrent_transfer":             decodeVirtualOp[FillRecurrentTransferOperation],
+	"failed_recurrent_transfer":           decodeVirtualOp[FailedRecurrentTransferOperation],
+}
+
+// GetOpsInBlock returns the operations of a block with their position in it.
+// With onlyVirtual set, only the virtual operations are returned.
+func (h *HiveRpcNode) GetOpsInBlock(blockNum int, onlyVirtual bool) ([]types.OperationObject, error) {
+	var query = hrpcQuery{method: "condenser_api.get_ops_in_block", params: []interface{}{blockNum, onlyVirtual}}
+	res, err := h.rpcExec(h.address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []types.OperationObject
+	err = json.Unmarshal(res, &ops)
+	if err != nil {
+		return nil, err
+	}
+	decodeOperationObjects(ops)
+	return ops, nil
+}
+
+// EnumVirtualOps returns the virtual operations of the blocks from startBlock
+// up to, but not including, endBlock, in block order.
+func (h *HiveRpcNode) EnumVirtualOps(startBlock int, endBlock int) ([]types.OperationObject, error) {
+	params := types.EnumVirtualOpsQueryParams{
+		BlockRangeBegin:   startBlock,
+		BlockRangeEnd:     endBlock,
+		IncludeReversible: true,
+	}
+
+	var ops []types.OperationObject
+	for {
+		var query = hrpcQuery{method: "account_history_api.enum_virtual_ops", params: params}
+		res, err := h.rpcExec(h.address, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var page types.EnumVirtualOpsResult
+		err = json.Unmarshal(res, &page)
+		if err != nil {
+			return nil, err
+		}
+		decodeOperationObjects(page.Ops)
+		ops = append(ops, page.Ops...)
+
+		done, err := nextVirtualOpsPage(&params, page)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return ops, nil
+		}
+	}
+}
+
+// nextVirtualOpsPage moves params to the page after page, as the node stops at
+// its own limit and tells where to continue. It reports whether the range is
+// done, and fails when the node returns a cursor that does not move forward.
+func nextVirtualOpsPage(params *types.EnumVirtualOpsQueryParams, page types.EnumVirtualOpsResult) (bool, error) {
+	nextOp, err := parseNonce(page.NextOperationBegin)
+	if err != nil {
+		return false, err
+	}
+	if nextOp == 0 || page.NextBlockRangeBegin >= params.BlockRangeEnd {
+		return true, nil
+	}
+	if page.NextBlockRangeBegin < params.BlockRangeBegin ||
+		(page.NextBlockRangeBegin == params.BlockRangeBegin && nextOp <= params.OperationBegin) {
+		return false, errors.New("enum_virtual_ops did not advance")
+	}
+	params.BlockRangeBegin = page.NextBlockRangeBegin
+	params.OperationBegin = nextOp
+	return false, nil
+}
+
+// StreamVirtualOps streams the virtual operations of new blocks, starting
+// after the current head block.
+func (h *HiveRpcNode) StreamVirtualOps() (<-chan types.OperationObject, error) {
+	opChan := make(chan types.OperationObject)
+
+	go func() {
+		defer close(opChan)
+
+		headBlock, err := h.getHeadBlockNum()
+		if err != nil {
+			log.Printf("Failed to fetch initial head block: %v", err)
+			return
+		}
+
+		currentBlock := headBlock + 1
+		for {
+			headBlock, err = h.getHeadBlockNum()
+			if err != nil {
+				log.Printf("Failed to fetch head block: %v. Retrying...", err)
+				time.Sleep(retryWaitTime)
+				continue
+			}
+			if headBlock < currentBlock {
+				time.Sleep(retryWaitTime)
+				continue
+			}
+
+			ops, err := h.EnumVirtualOps(currentBlock, headBlock+1)
+			if err != nil {
+				log.Printf("Error fetching virtual ops of blocks %d to %d: %v. Retrying...", currentBlock, headBlock, err)
+				time.Sleep(retryWaitTime)
+				continue
+			}
+
+			for _, op := range ops {
+				opChan <- op
+			}
+			currentBlock = headBlock + 1
+		}
+	}()
+
+	return opChan, nil
+}
+
+// StreamOperations streams the operations of new blocks, the regular
+// operations interleaved with the virtual operations in block order.
+func (h *HiveRpcNode) StreamOperations() (<-chan types.OperationObject, error) {
+	blocks, err := h.StreamBlocks()
+	if err != nil {
+		return nil, err
+	}
+
+	opChan := make(chan types.OperationObject)
+	go func() {
+		defer close(opChan)
+		for block := range blocks {
+			blockNum, err := blockNumFromId(block.BlockID)
+			if err != nil {
+				log.Printf("Failed to read block number: %v", err)
+				return
+			}
+			for {
+				vops, err := h.EnumVirtualOps(blockNum, blockNum+1)
+				if err == nil {
+					for _, op := range blockOperations(blockNum, block, vops) {
+						opChan <- op
+					}
+					break
+				}
+				log.Printf("Error fetching virtual ops of block %d: %v. Retrying...", blockNum, err)
+				time.Sleep(retryWaitTime)
+			}
+		}
+	}()
+
+	return opChan, nil
+}
+
+func (h *HiveRpcNode) getHeadBlockNum() (int, error) {
+	res, err := h.GetDynamicGlobalProps()
+	if err != nil {
+		return 0, err
+	}
+
+	var props globalProps
+	err = json.Unmarshal(res, &props)
+	if err != nil {
+		return 0, err
+	}
+	return props.HeadBlockNumber, nil
+}
+
+// blockNumFromId returns the block number encoded in the first four bytes of a block id.
+func blockNumFromId(blockId string) (int, error) {
+	id, err := hex.DecodeString(blockId)
+	if err != nil {
+		return 0, err
+	}
+	if len(id) < 4 {
+		return 0, errors.New("invalid block id: " + blockId)
+	}
+	return int(binary.BigEndian.Uint32(id)), nil
+}
+
+// blockOperations returns the operations of a block in the order the chain
+// applied them: the operations of each transaction, each followed by the
+// virtual operations it caused, then the virtual operations of the block
+// itself, such as the producer reward.
+func blockOperations(blockNum int, block types.Block, vops []types.OperationObject) []types.OperationObject {
+	vops = append([]types.OperationObject{}, vops...)
+	sort.SliceStable(vops, func(i, j int) bool {
+		if vops[i].TrxInBlock != vops[j].TrxInBlock {
+			return vops[i].TrxInBlock < vops[j].TrxInBlock
+		}
+		return vops[i].OpInTrx < vops[j].OpInTrx
+	})
+
+	var ops []types.OperationObject
+	next := 0
+	for trxInBlock, tx := range block.Transactions {
+		var trxId string
+		if trxInBlock < len(block.TransactionIds) {
+			trxId = block.TransactionIds[trxInBlock]
+		}
+		for opInTrx, op := range tx.Operations {
+			ops = append(ops, types.OperationObject{
+				TrxId:      trxId,
+				Block:      blockNum,
+				TrxInBlock: uint32(trxInBlock),
+				OpInTrx:    uint32(opInTrx),
+				Timestamp:  block.Timestamp,
+				Op:         op,
+			})
+		}
+		for next < len(vops) && vops[next].TrxInBlock <= uint32(trxInBlock) {
+			ops = append(ops, vops[next])
+			next++
+		}
+	}
+
+	return append(ops, vops[next:]...)
+}
@@ -0,0 +1,187 @@
+package hivego
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+func TestVirtualOpIds(t *testing.T) {
+	opIds := getHiveOpIds()
+	for name, id := range getHiveVirtualOpIds() {
+		if _, ok := opIds[name]; ok || id < uint64(len(opIds)) {
+			t.Error("Virtual operation overlaps the regular operations:", name, id)
+		}
+	}
+
+	for name := range virtualOpDecoders {
+		if _, ok := getHiveVirtualOpIds()[name+"_operation"]; !ok {
+			t.Error("No id for virtual operation", name)
+		}
+		if _, ok := appbaseSchemas[name]; !ok {
+			t.Error("No appbase schema for virtual operation", name)
+		}
+	}
+}
+
+func TestDecodeVirtualOps(t *testing.T) {
+	condenser := `{"trx_id":"0000000000000000000000000000000000000000","block":80000000,"trx_in_block":4294967295,"op_in_trx":0,"virtual_op":true,"timestamp":"2023-10-23T10:00:00","op":["producer_reward",{"producer":"alice","vesting_shares":"480.123456 VESTS"}]}`
+	appbase := `{"trx_id":"0000000000000000000000000000000000000000","block":80000000,"trx_in_block":4294967295,"op_in_trx":0,"virtual_op":true,"timestamp":"2023-10-23T10:00:00","op":{"type":"producer_reward_operation","value":{"producer":"alice","vesting_shares":{"amount":"480123456","precision":6,"nai":"@@000000037"}}}}`
+
+	for _, data := range []string{condenser, appbase} {
+		var obj types.OperationObject
+		err := json.Unmarshal([]byte(data), &obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := obj.Op.Value.(map[string]interface{}); !ok {
+			t.Error("expected a map before decoding, got", obj.Op.Value)
+		}
+		obj.Op, err = DecodeOperation(obj.Op)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := ProducerRewardOperation{Producer: "alice", VestingShares: "480.123456 VESTS"}
+		if obj.Op.Type != types.OperationType.ProducerReward || obj.Op.Value != expected {
+			t.Error("got", obj.Op.Type, obj.Op.Value)
+		}
+		if !obj.VirtualOp || obj.TrxInBlock != 4294967295 || obj.Block != 80000000 {
+			t.Error("got position", obj.Block, obj.TrxInBlock, obj.VirtualOp)
+		}
+	}
+
+	var fill types.Operation
+	err := json.Unmarshal([]byte(`["fill_order",{"current_owner":"alice","current_orderid":1,"current_pays":"1.000 HBD","open_owner":"bob","open_orderid":2,"open_pays":"3.500 HIVE"}]`), &fill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fill, err = DecodeOperation(fill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := FillOrderOperation{CurrentOwner: "alice", CurrentOrderId: 1, CurrentPays: "1.000 HBD", OpenOwner: "bob", OpenOrderId: 2, OpenPays: "3.500 HIVE"}
+	if fill.Value != expected {
+		t.Error("got", fill.Value)
+	}
+
+	var unknown types.Operation
+	err = json.Unmarshal([]byte(`["hardfork",{"hardfork_id":28}]`), &unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, err = DecodeOperation(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := unknown.Value.(map[string]interface{}); !ok {
+		t.Error("expected a map for an untyped virtual operation, got", unknown.Value)
+	}
+}
+
+func TestEncodeVirtualOp(t *testing.T) {
+	op := types.Operation{Type: types.OperationType.Interest, Value: InterestOperation{Owner: "alice", Interest: "0.015 HBD", IsSavedIntoHbdBalance: true}}
+	appbaseOp, err := EncodeOperation(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opJs, err := json.Marshal(appbaseOp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(opJs), `"nai":"@@000000013"`) {
+		t.Error("expected a NAI asset in", string(opJs))
+	}
+
+	var decoded types.Operation
+	err = json.Unmarshal(opJs, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = DecodeOperation(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != op.Type || decoded.Value != op.Value {
+		t.Error("got", decoded, "expected", op)
+	}
+}
+
+func TestNextVirtualOpsPage(t *testing.T) {
+	params := types.EnumVirtualOpsQueryParams{BlockRangeBegin: 100, BlockRangeEnd: 200}
+
+	done, err := nextVirtualOpsPage(&params, types.EnumVirtualOpsResult{NextBlockRangeBegin: 100, NextOperationBegin: "42"})
+	if err != nil || done || params.BlockRangeBegin != 100 || params.OperationBegin != 42 {
+		t.Fatal("got", done, err, params)
+	}
+
+	_, err = nextVirtualOpsPage(&params, types.EnumVirtualOpsResult{NextBlockRangeBegin: 100, NextOperationBegin: "42"})
+	if err == nil {
+		t.Error("expected an error for a cursor that did not move")
+	}
+	_, err = nextVirtualOpsPage(&params, types.EnumVirtualOpsResult{NextBlockRangeBegin: 99, NextOperationBegin: "50"})
+	if err == nil {
+		t.Error("expected an error for a cursor that moved back")
+	}
+
+	done, err = nextVirtualOpsPage(&params, types.EnumVirtualOpsResult{NextBlockRangeBegin: 200, NextOperationBegin: "60"})
+	if err != nil || !done {
+		t.Error("expected the range to be done, got", done, err)
+	}
+	done, err = nextVirtualOpsPage(&params, types.EnumVirtualOpsResult{NextBlockRangeBegin: 0, NextOperationBegin: "0"})
+	if err != nil || !done {
+		t.Error("expected the range to be done, got", done, err)
+	}
+}
+
+func TestBlockNumFromId(t *testing.T) {
+	num, err := blockNumFromId("04c4b4004ac8b5f4b1a3e6e4b8aa1c9e4c5e2d7f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != 80000000 {
+		t.Error("got", num)
+	}
+
+	_, err = blockNumFromId("04c4")
+	if err == nil {
+		t.Error("expected an error for a short block id")
+	}
+}
+
+func TestBlockOperations(t *testing.T) {
+	vote := types.Operation{Type: types.OperationType.Vote, Value: getTestVoteOp()}
+	order := types.Operation{Type: types.OperationType.LimitOrderCreate, Value: LimitOrderCreateOperation{Owner: "alice"}}
+	block := types.Block{
+		Timestamp:      "2023-10-23T10:00:00",
+		TransactionIds: []string{"aa", "bb"},
+		Transactions: []types.Transaction{
+			{Operations: []types.Operation{vote}},
+			{Operations: []types.Operation{order}},
+		},
+	}
+	vops := []types.OperationObject{
+		{TrxInBlock: 4294967295, VirtualOp: true, Op: types.Operation{Type: types.OperationType.ProducerReward}},
+		{TrxId: "bb", TrxInBlock: 1, OpInTrx: 1, VirtualOp: true, Op: types.Operation{Type: types.OperationType.FillOrder}},
+	}
+
+	ops := blockOperations(80000000, block, vops)
+	var got []string
+	for _, op := range ops {
+		got = append(got, op.Op.Type)
+	}
+	expected := []string{
+		types.OperationType.Vote,
+		types.OperationType.LimitOrderCreate,
+		types.OperationType.FillOrder,
+		types.OperationType.ProducerReward,
+	}
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Error("got", got, "expected", expected)
+	}
+	if ops[1].TrxId != "bb" || ops[1].Block != 80000000 || ops[1].TrxInBlock != 1 || ops[1].Timestamp != block.Timestamp {
+		t.Error("got", ops[1])
+	}
+}
@@ -0,0 +1,297 @@
+package hivego
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/deathwingtheboss/hivego/types"
+)
+
+type AccountWitnessVoteOperation struct {
+	Account string `json:"account"`
+	Witness string `json:"witness"`
+	Approve bool   `json:"approve"`
+}
+
+func (o AccountWitnessVoteOperation) opName() string {
+	return "account_witness_vote"
+}
+
+func (o AccountWitnessVoteOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Account}}
+}
+
+func (h *HiveRpcNode) WitnessVote(account string, witness string, approve bool, wif *string) (string, error) {
+	op := AccountWitnessVoteOperation{account, witness, approve}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+type AccountWitnessProxyOperation struct {
+	Account string `json:"account"`
+	Proxy   string `json:"proxy"`
+}
+
+func (o AccountWitnessProxyOperation) opName() string {
+	return "account_witness_proxy"
+}
+
+func (o AccountWitnessProxyOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Account}}
+}
+
+// SetWitnessProxy lets proxy vote for witnesses and proposals on behalf of account.
+// An empty proxy clears it.
+func (h *HiveRpcNode) SetWitnessProxy(account string, proxy string, wif *string) (string, error) {
+	op := AccountWitnessProxyOperation{account, proxy}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// ChainProperties are the chain parameters a witness votes on with witness_update.
+type ChainProperties struct {
+	AccountCreationFee string `json:"account_creation_fee"`
+	MaximumBlockSize   uint32 `json:"maximum_block_size"`
+	HbdInterestRate    uint16 `json:"hbd_interest_rate"`
+}
+
+type WitnessUpdateOperation struct {
+	Owner           string          `json:"owner"`
+	Url             string          `json:"url"`
+	BlockSigningKey string          `json:"block_signing_key"`
+	Props           ChainProperties `json:"props"`
+	Fee             string          `json:"fee"`
+}
+
+func (o WitnessUpdateOperation) opName() string {
+	return "witness_update"
+}
+
+func (o WitnessUpdateOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Active: []string{o.Owner}}
+}
+
+// WitnessUpdate declares owner as a witness, or updates its url, signing key and properties.
+func (h *HiveRpcNode) WitnessUpdate(owner string, url string, blockSigningKey string, props ChainProperties, fee string, wif *string) (string, error) {
+	op := WitnessUpdateOperation{owner, url, blockSigningKey, props, fee}
+
+	return h.broadcast([]HiveOperation{op}, wif)
+}
+
+// WitnessProps holds the properties published with witness_set_properties.
+// Key is the witness' current signing key and is always sent; the other
+// properties are only sent when set. Other holds the properties without a
+// field here, such as account_subsidy_budget, by name with their serialized
+// value as hex.
+type WitnessProps struct {
+	Key                string
+	AccountCreationFee *string
+	HbdExchangeRate    *Price
+	MaximumBlockSize   *uint32
+	NewSigningKey      *string
+	Url                *string
+	HbdInterestRate    *uint16
+	Other              map[string]string
+}
+
+// serialize encodes each property to the bytes the chain expects in the props flat_map.
+func (p WitnessProps) serialize() (map[string][]byte, error) {
+	props := make(map[string][]byte)
+	var buf bytes.Buffer
+
+	for name, value := range p.Other {
+		b, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		props[name] = b
+	}
+
+	if p.Key == "" {
+		return nil, errors.New("the current signing key is required")
+	}
+	err := appendPublicKey(p.Key, &buf)
+	if err != nil {
+		return nil, err
+	}
+	props["key"] = append([]byte{}, buf.Bytes()...)
+
+	if p.AccountCreationFee != nil {
+		buf.Reset()
+		err = appendVAsset(*p.AccountCreationFee, &buf)
+		if err != nil {
+			return nil, err
+		}
+		props["account_creation_fee"] = append([]byte{}, buf.Bytes()...)
+	}
+	if p.HbdExchangeRate != nil {
+		buf.Reset()
+		err = appendPrice(*p.HbdExchangeRate, &buf)
+		if err != nil {
+			return nil, err
+		}
+		props["hbd_exchange_rate"] = append([]byte{}, buf.Bytes()...)
+	}
+	if p.MaximumBlockSize != nil {
+		buf.Reset()
+		props["maximum_block_size"] = append([]byte{}, appendUint32(*p.MaximumBlockSize, &buf).Bytes()...)
+	}
+	if p.NewSigningKey != nil {
+		buf.Reset()
+		err = appendPublicKey(*p.NewSigningKey, &buf)
+		if err != nil {
+			return nil, err
+		}
+		props["new_signing_key"] = append([]byte{}, buf.Bytes()...)
+	}
+	if p.Url != nil {
+		buf.Reset()
+		props["url"] = append([]byte{}, appendVString(*p.Url, &buf).Bytes()...)
+	}
+	if p.HbdInterestRate != nil {
+		buf.Reset()
+		props["hbd_interest_rate"] = append([]byte{}, appendUint16(*p.HbdInterestRate, &buf).Bytes()...)
+	}
+
+	return props, nil
+}
+
+func (p *WitnessProps) deserialize(name string, value []byte) error {
+	r := newHiveReader(value)
+	var err error
+	switch name {
+	case "key":
+		p.Key, err = r.readPublicKey()
+	case "account_creation_fee":
+		var fee string
+		fee, err = r.readVAsset()
+		p.AccountCreationFee = &fee
+	case "hbd_exchange_rate", "sbd_exchange_rate":
+		var rate Price
+		rate, err = r.readPrice()
+		p.HbdExchangeRate = &rate
+	case "maximum_block_size":
+		var size uint32
+		size, err = r.readUint32()
+		p.MaximumBlockSize = &size
+	case "new_signing_key":
+		var key string
+		key, err = r.readPublicKey()
+		p.NewSigningKey = &key
+	case "url":
+		var url string
+		url, err = r.readVString()
+		p.Url = &url
+	case "hbd_interest_rate", "sbd_interest_rate":
+		var rate uint16
+		rate, err = r.readUint16()
+		p.HbdInterestRate = &rate
+	default:
+		if p.Other == nil {
+			p.Other = make(map[string]string)
+		}
+		p.Other[name] = hex.EncodeToString(value)
+	}
+	return err
+}
+
+func sortedPropNames(props map[string][]byte) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WitnessSetPropertiesOperation publishes witness properties. It is signed
+// with the witness' current signing key rather than an account key.
+type WitnessSetPropertiesOperation struct {
+	Owner string       `json:"owner"`
+	Props WitnessProps `json:"-"`
+}
+
+func (o WitnessSetPropertiesOperation) opName() string {
+	return "witness_set_properties"
+}
+
+func (o WitnessSetPropertiesOperation) requiredAuths() RequiredAuthorities {
+	signingKeyAuth := NewKeyAuthority(o.Props.Key).toAccountAuthority()
+	return RequiredAuthorities{Other: []types.Authority{signingKeyAuth}}
+}
+
+func (o WitnessSetPropertiesOperation) MarshalJSON() ([]byte, error) {
+	props, err := o.Props.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	propsJs := [][2]string{}
+	for _, name := range sortedPropNames(props) {
+		propsJs = append(propsJs, [2]string{name, hex.EncodeToString(props[name])})
+	}
+
+	return json.Marshal(struct {
+		Owner      string        `json:"owner"`
+		Props      [][2]string   `json:"props"`
+		Extensions []interface{} `json:"extensions"`
+	}{o.Owner, propsJs, []interface{}{}})
+}
+
+func (o *WitnessSetPropertiesOperation) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Owner string      `json:"owner"`
+		Props [][2]string `json:"props"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	o.Owner = raw.Owner
+	o.Props = WitnessProps{}
+	for _, prop := range raw.Props {
+		value, err := hex.DecodeString(prop[1])
+		if err != nil {
+			return err
+		}
+		err = o.Props.deserialize(prop[0], value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WitnessSetProperties publishes props for the witness owner, signed with the
+// WIF of its current signing key. Setting props.NewSigningKey rotates the key.
+func (h *HiveRpcNode) WitnessSetProperties(owner string, props WitnessProps, signingWif *string) (string, error) {
+	op := WitnessSetPropertiesOperation{owner, props}
+
+	return h.broadcast([]HiveOperation{op}, signingWif)
+}
+
+type DeclineVotingRightsOperation struct {
+	Account string `json:"account"`
+	Decline bool   `json:"decline"`
+}
+
+func (o DeclineVotingRightsOperation) opName() string {
+	return "decline_voting_rights"
+}
+
+func (o DeclineVotingRightsOperation) requiredAuths() RequiredAuthorities {
+	return RequiredAuthorities{Owner: []string{o.Account}}
+}
+
+// DeclineVotingRights permanently gives up the witness and proposal votes of
+// account once the 30 day waiting period ends. Calling it with decline false
+// cancels a pending request. It needs the owner key.
+func (h *HiveRpcNode) DeclineVotingRights(account string, decline bool, ownerWif *string) (string, error) {
+	op := DeclineVotingRightsOperation{account, decline}
+
+	return h.broadcast([]HiveOperation{op}, ownerWif)
+}
@@ -0,0 +1,60 @@
+package hivego
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestSerializeOpWitnessSetPropertiesOperation(t *testing.T) {
+	url := "x"
+	blockSize := uint32(65536)
+	op := WitnessSetPropertiesOperation{
+		Owner: "a",
+		Props: WitnessProps{Key: "STM7dzxQo2aaav9weydSVAwqewcUz2GbUwyWrAVqkdiKsD6V1uX8B", Url: &url, MaximumBlockSize: &blockSize},
+	}
+	got, err := op.serializeOp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := []byte{3, 106, 48, 22, 243, 45, 96, 255, 51, 197, 8, 179, 85, 147, 131, 32, 165, 214, 76, 64, 90, 168, 63, 67, 124, 7, 139, 26, 114, 145, 144, 94, 153}
+	var expected []byte
+	expected = append(expected, 42, 1, 97, 3)
+	expected = append(expected, 3, 'k', 'e', 'y', 33)
+	expected = append(expected, key...)
+	expected = append(expected, 18)
+	expected = append(expected, []byte("maximum_block_size")...)
+	expected = append(expected, 4, 0, 0, 1, 0)
+	expected = append(expected, 3, 'u', 'r', 'l', 2, 1, 'x')
+	expected = append(expected, 0)
+	if !bytes.Equal(got, expected) {
+		t.Error("Expected", expected, "got", got)
+	}
+}
+
+func TestWitnessSetPropertiesJsonRoundTrip(t *testing.T) {
+	rate := Price{Base: "0.250 HBD", Quote: "1.000 HIVE"}
+	interest := uint16(1500)
+	op := WitnessSetPropertiesOperation{
+		Owner: "a",
+		Props: WitnessProps{Key: "STM1111111111111111111111111111111114T1Anm", HbdExchangeRate: &rate, HbdInterestRate: &interest},
+	}
+
+	js, err := json.Marshal(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded WitnessSetPropertiesOperation
+	if err = json.Unmarshal(js, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Props.Key != op.Props.Key || *decoded.Props.HbdExchangeRate != rate || *decoded.Props.HbdInterestRate != interest {
+		t.Error("Expected", op.Props, "got", decoded.Props)
+	}
+	if got := decoded.requiredAuths().Other[0].KeyWeights()[op.Props.Key]; got != 1 {
+		t.Error("Expected the signing key to be required with weight 1, got", got)
+	}
+}